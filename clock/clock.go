@@ -0,0 +1,50 @@
+package clock
+
+import "time"
+
+// Clock provides current time and timers.
+type Clock interface {
+	// Now returns current time.
+	Now() time.Time
+	// NewTimer creates Timer sending current time to its channel after duration d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer sends current time to its channel once after the duration passes, like time.Timer.
+type Timer interface {
+	// C returns channel the time is sent to.
+	C() <-chan time.Time
+	// Stop prevents Timer from firing, returning false if Timer has already fired or been stopped.
+	Stop() bool
+}
+
+// Real returns Clock backed by package time.
+func Real() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{Timer: time.NewTimer(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// OrReal returns c or the real clock (see Real) if c is nil.
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real()
+	}
+	return c
+}
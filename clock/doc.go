@@ -0,0 +1,4 @@
+// Package clock provides Clock interface used by time-based iterators
+// (e.g. itertools.BatchedTimeout) to measure time, along with the real clock
+// and the fake one, which allows to test time-based behavior without sleeping.
+package clock
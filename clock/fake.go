@@ -0,0 +1,97 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is Clock whose time changes only by explicit calls of Advance.
+// Fake is safe for concurrent use, so the code under test can wait for its timers
+// in one goroutine while the test advances the time in another one.
+type Fake struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers map[*fakeTimer]struct{}
+}
+
+// NewFake creates Fake with current time set to now.
+func NewFake(now time.Time) *Fake {
+	f := &Fake{
+		now:    now,
+		timers: make(map[*fakeTimer]struct{}),
+	}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now returns current time of Fake.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// NewTimer creates Timer firing when the time of Fake is advanced by d.
+// Timer with non-positive duration fires immediately.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t := &fakeTimer{
+		clock:    f,
+		deadline: f.now.Add(d),
+		c:        make(chan time.Time, 1),
+	}
+	if d <= 0 {
+		t.c <- f.now
+		return t
+	}
+	f.timers[t] = struct{}{}
+	f.cond.Broadcast()
+	return t
+}
+
+// Advance moves the time of Fake forward by d, firing timers with passed deadlines.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	for t := range f.timers {
+		if !t.deadline.After(f.now) {
+			delete(f.timers, t)
+			t.c <- f.now
+		}
+	}
+	f.cond.Broadcast()
+}
+
+// BlockUntil blocks until at least n timers of Fake are active (created and neither fired nor stopped).
+// BlockUntil allows to wait until the code under test starts waiting for the time to pass.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.timers) < n {
+		f.cond.Wait()
+	}
+}
+
+type fakeTimer struct {
+	clock    *Fake
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	if _, active := t.clock.timers[t]; !active {
+		return false
+	}
+	delete(t.clock.timers, t)
+	t.clock.cond.Broadcast()
+	return true
+}
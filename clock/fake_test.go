@@ -0,0 +1,77 @@
+package clock_test
+
+import (
+	"github.com/KSpaceer/itertools/clock"
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("advance", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		timer := fake.NewTimer(time.Minute)
+
+		fake.Advance(30 * time.Second)
+		select {
+		case <-timer.C():
+			t.Fatalf("timer fired too early")
+		default:
+		}
+
+		fake.Advance(30 * time.Second)
+		select {
+		case now := <-timer.C():
+			if expected := start.Add(time.Minute); !now.Equal(expected) {
+				t.Errorf("expected %v, got %v", expected, now)
+			}
+		default:
+			t.Fatalf("expected timer to fire")
+		}
+
+		if timer.Stop() {
+			t.Errorf("expected Stop to return false for fired timer")
+		}
+		if now := fake.Now(); !now.Equal(start.Add(time.Minute)) {
+			t.Errorf("expected %v, got %v", start.Add(time.Minute), now)
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		timer := fake.NewTimer(time.Second)
+
+		if !timer.Stop() {
+			t.Errorf("expected Stop to return true for active timer")
+		}
+		fake.Advance(time.Second)
+		select {
+		case <-timer.C():
+			t.Errorf("stopped timer fired")
+		default:
+		}
+	})
+
+	t.Run("block until", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			<-fake.NewTimer(time.Second).C()
+		}()
+
+		fake.BlockUntil(1)
+		fake.Advance(time.Second)
+		<-done
+	})
+
+	t.Run("non-positive duration", func(t *testing.T) {
+		fake := clock.NewFake(start)
+		select {
+		case <-fake.NewTimer(0).C():
+		default:
+			t.Errorf("expected timer to fire immediately")
+		}
+	})
+}
@@ -0,0 +1,228 @@
+package itertools
+
+// Product creates new iterator that yields cartesian product of t and u
+// as Pairs in lexicographic order, i.e. for every element of t
+// all elements of u are yielded in order.
+func Product[T, U any](t []T, u []U) *Iterator[Pair[T, U]] {
+	var tIdx, uIdx int
+	return New(func() (Pair[T, U], bool) {
+		if tIdx >= len(t) || len(u) == 0 {
+			return Pair[T, U]{}, false
+		}
+		result := Pair[T, U]{
+			First:  t[tIdx],
+			Second: u[uIdx],
+		}
+		uIdx++
+		if uIdx >= len(u) {
+			uIdx = 0
+			tIdx++
+		}
+		return result, true
+	})
+}
+
+// ProductN creates new iterator that yields n-ary cartesian product of given slices,
+// where k-th element of each yielded slice belongs to pools[k].
+// Slices are yielded in lexicographic order (the last position changes the fastest).
+// If pools is empty, ProductN yields single empty slice.
+func ProductN[T any](pools [][]T, opts ...AllocationOption) *Iterator[[]T] {
+	for _, pool := range pools {
+		if len(pool) == 0 {
+			return empty[[]T]()
+		}
+	}
+	indices := make([]int, len(pools))
+	return newCombinatoricIterator(
+		len(pools),
+		func(buf []T) {
+			for k, idx := range indices {
+				buf[k] = pools[k][idx]
+			}
+		},
+		func() bool {
+			for k := len(indices) - 1; k >= 0; k-- {
+				indices[k]++
+				if indices[k] < len(pools[k]) {
+					return true
+				}
+				indices[k] = 0
+			}
+			return false
+		},
+		opts,
+	)
+}
+
+// Permutations creates new iterator that yields all r-length permutations of elements of s.
+// Permutations are yielded in lexicographic order of elements' positions in s,
+// so if s is sorted, the permutations are yielded in sorted order.
+// Elements are treated as unique based on their positions, not on their values.
+// If r is negative or greater than len(s), Permutations returns empty iterator.
+func Permutations[T any](s []T, r int, opts ...AllocationOption) *Iterator[[]T] {
+	n := len(s)
+	if r < 0 || r > n {
+		return empty[[]T]()
+	}
+	indices := make([]int, n)
+	for k := range indices {
+		indices[k] = k
+	}
+	cycles := make([]int, r)
+	for k := range cycles {
+		cycles[k] = n - k
+	}
+	return newCombinatoricIterator(
+		r,
+		func(buf []T) {
+			for k := range buf {
+				buf[k] = s[indices[k]]
+			}
+		},
+		func() bool {
+			for k := r - 1; k >= 0; k-- {
+				cycles[k]--
+				if cycles[k] == 0 {
+					moved := indices[k]
+					copy(indices[k:], indices[k+1:])
+					indices[n-1] = moved
+					cycles[k] = n - k
+				} else {
+					j := n - cycles[k]
+					indices[k], indices[j] = indices[j], indices[k]
+					return true
+				}
+			}
+			return false
+		},
+		opts,
+	)
+}
+
+// Combinations creates new iterator that yields all r-length subsequences of elements of s.
+// Combinations are yielded in lexicographic order of elements' positions in s,
+// so if s is sorted, the combinations are yielded in sorted order.
+// Elements are treated as unique based on their positions, not on their values.
+// If r is negative or greater than len(s), Combinations returns empty iterator.
+func Combinations[T any](s []T, r int, opts ...AllocationOption) *Iterator[[]T] {
+	n := len(s)
+	if r < 0 || r > n {
+		return empty[[]T]()
+	}
+	indices := make([]int, r)
+	for k := range indices {
+		indices[k] = k
+	}
+	return newCombinatoricIterator(
+		r,
+		func(buf []T) {
+			for k, idx := range indices {
+				buf[k] = s[idx]
+			}
+		},
+		func() bool {
+			k := r - 1
+			for ; k >= 0 && indices[k] == k+n-r; k-- {
+			}
+			if k < 0 {
+				return false
+			}
+			indices[k]++
+			for j := k + 1; j < r; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+			return true
+		},
+		opts,
+	)
+}
+
+// CombinationsWithReplacement creates new iterator that yields all r-length subsequences
+// of elements of s allowing individual elements to be repeated.
+// Combinations are yielded in lexicographic order of elements' positions in s,
+// so if s is sorted, the combinations are yielded in sorted order.
+// If r is negative or s is empty (and r is positive),
+// CombinationsWithReplacement returns empty iterator.
+func CombinationsWithReplacement[T any](s []T, r int, opts ...AllocationOption) *Iterator[[]T] {
+	n := len(s)
+	if r < 0 || (n == 0 && r > 0) {
+		return empty[[]T]()
+	}
+	indices := make([]int, r)
+	return newCombinatoricIterator(
+		r,
+		func(buf []T) {
+			for k, idx := range indices {
+				buf[k] = s[idx]
+			}
+		},
+		func() bool {
+			k := r - 1
+			for ; k >= 0 && indices[k] == n-1; k-- {
+			}
+			if k < 0 {
+				return false
+			}
+			next := indices[k] + 1
+			for j := k; j < r; j++ {
+				indices[j] = next
+			}
+			return true
+		},
+		opts,
+	)
+}
+
+// PowerSet creates new iterator that yields all subsequences of elements of s,
+// starting from the empty one. Subsequences are yielded in order of increasing length
+// and subsequences of the same length are yielded in the same order as by Combinations.
+func PowerSet[T any](s []T, opts ...AllocationOption) *Iterator[[]T] {
+	iters := make([]*Iterator[[]T], 0, len(s)+1)
+	for r := 0; r <= len(s); r++ {
+		iters = append(iters, Combinations(s, r, opts...))
+	}
+	return Chain(iters...)
+}
+
+// newCombinatoricIterator creates iterator yielding slices of given size.
+// Function fill writes current element into the slice and
+// function advance moves the state to the next element, returning false
+// if there are no more elements.
+func newCombinatoricIterator[T any](
+	size int,
+	fill func([]T),
+	advance func() bool,
+	opts []AllocationOption,
+) *Iterator[[]T] {
+	var options allocOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	var (
+		buf     []T
+		started bool
+		stopped bool
+	)
+	return New(func() ([]T, bool) {
+		if stopped {
+			return nil, false
+		}
+		if started && !advance() {
+			stopped = true
+			return nil, false
+		}
+		started = true
+		if buf == nil || !options.reuseBuffer {
+			buf = make([]T, size)
+		}
+		fill(buf)
+		return buf, true
+	})
+}
+
+func empty[T any]() *Iterator[T] {
+	return New(func() (T, bool) {
+		var zero T
+		return zero, false
+	})
+}
@@ -0,0 +1,179 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"testing"
+)
+
+func TestCombinatorics(t *testing.T) {
+	t.Run("product", func(t *testing.T) {
+		result := itertools.Product([]int{1, 2}, []string{"a", "b", "c"}).Collect()
+
+		expected := []itertools.Pair[int, string]{
+			{First: 1, Second: "a"},
+			{First: 1, Second: "b"},
+			{First: 1, Second: "c"},
+			{First: 2, Second: "a"},
+			{First: 2, Second: "b"},
+			{First: 2, Second: "c"},
+		}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty product", func(t *testing.T) {
+		i := itertools.Product([]int{1, 2}, []string{})
+		if i.Next() {
+			t.Errorf("did not expect elements in empty product; elem: %v", i.Elem())
+		}
+	})
+
+	t.Run("product n", func(t *testing.T) {
+		result := itertools.ProductN([][]int{{1, 2}, {3}, {4, 5}}).Collect()
+
+		expected := [][]int{
+			{1, 3, 4},
+			{1, 3, 5},
+			{2, 3, 4},
+			{2, 3, 5},
+		}
+
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("product n of no pools", func(t *testing.T) {
+		result := itertools.ProductN[int](nil).Collect()
+		if len(result) != 1 || len(result[0]) != 0 {
+			t.Errorf("expected single empty slice, got %v", result)
+		}
+	})
+
+	t.Run("permutations", func(t *testing.T) {
+		result := itertools.Permutations([]int{1, 2, 3}, 2).Collect()
+
+		expected := [][]int{
+			{1, 2},
+			{1, 3},
+			{2, 1},
+			{2, 3},
+			{3, 1},
+			{3, 2},
+		}
+
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("full permutations", func(t *testing.T) {
+		result := itertools.Permutations([]int{1, 2, 3, 4}, 4).Collect()
+
+		if len(result) != 24 {
+			t.Errorf("expected %d permutations, got %d", 24, len(result))
+		}
+		for k := 1; k < len(result); k++ {
+			if sliceCmp(result[k-1], result[k]) >= 0 {
+				t.Errorf("permutations are not in lexicographic order: %v", result)
+				break
+			}
+		}
+	})
+
+	t.Run("permutations of too large size", func(t *testing.T) {
+		i := itertools.Permutations([]int{1, 2, 3}, 4)
+		if i.Next() {
+			t.Errorf("did not expect elements; elem: %v", i.Elem())
+		}
+	})
+
+	t.Run("combinations", func(t *testing.T) {
+		result := itertools.Combinations([]int{1, 2, 3, 4}, 2).Collect()
+
+		expected := [][]int{
+			{1, 2},
+			{1, 3},
+			{1, 4},
+			{2, 3},
+			{2, 4},
+			{3, 4},
+		}
+
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("combinations with replacement", func(t *testing.T) {
+		result := itertools.CombinationsWithReplacement([]int{1, 2, 3}, 2).Collect()
+
+		expected := [][]int{
+			{1, 1},
+			{1, 2},
+			{1, 3},
+			{2, 2},
+			{2, 3},
+			{3, 3},
+		}
+
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("power set", func(t *testing.T) {
+		result := itertools.PowerSet([]int{1, 2, 3}).Collect()
+
+		expected := [][]int{
+			{},
+			{1},
+			{2},
+			{3},
+			{1, 2},
+			{1, 3},
+			{2, 3},
+			{1, 2, 3},
+		}
+
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("buffer reuse", func(t *testing.T) {
+		i := itertools.Combinations([]int{1, 2, 3}, 2, itertools.WithBufferReuse())
+
+		var (
+			buffers  [][]int
+			expected = [][]int{{1, 2}, {1, 3}, {2, 3}}
+		)
+		for k := 0; i.Next(); k++ {
+			if !sliceEqual(expected[k], i.Elem()) {
+				t.Errorf("expected %v, got %v", expected[k], i.Elem())
+			}
+			buffers = append(buffers, i.Elem())
+		}
+
+		for k := 1; k < len(buffers); k++ {
+			if &buffers[k][0] != &buffers[0][0] {
+				t.Errorf("expected buffer to be reused")
+				break
+			}
+		}
+	})
+}
+
+func nestedSliceEqual[T comparable](a, b [][]T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !sliceEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,224 @@
+package itertools
+
+import (
+	"container/list"
+	"math"
+)
+
+// Dedup creates new iterator that yields elements of source iterator
+// skipping consecutive duplicates, i.e. elements equal to the previous element.
+// Dedup requires space complexity of O(1).
+func Dedup[T comparable](i *Iterator[T]) *Iterator[T] {
+	return DedupFunc(i, identity[T])
+}
+
+// DedupFunc creates new iterator that yields elements of source iterator
+// skipping consecutive duplicates. Elements are duplicates if f returns equal values for them.
+// DedupFunc requires space complexity of O(1).
+func DedupFunc[T any, U comparable](i *Iterator[T], f func(T) U) *Iterator[T] {
+	var (
+		prev    U
+		started bool
+		zero    T
+	)
+	return New(func() (T, bool) {
+		for {
+			v, ok := i.f()
+			if !ok {
+				return zero, false
+			}
+			key := f(v)
+			if !started || key != prev {
+				started = true
+				prev = key
+				return v, true
+			}
+		}
+	}).onClose(i.Close).withHint(func() (int, int) {
+		lower, upper := i.sizeHint()
+		return min(lower, 1), upper
+	})
+}
+
+// UniqWindow creates new iterator that yields elements of source iterator
+// which are not equal to any of window previous elements of source iterator.
+// If window is non-positive, all elements are yielded.
+// UniqWindow requires space complexity of O(window).
+func UniqWindow[T comparable](i *Iterator[T], window int) *Iterator[T] {
+	return UniqWindowFunc(i, identity[T], window)
+}
+
+// UniqWindowFunc creates new iterator that yields elements of source iterator
+// which are not duplicates of any of window previous elements of source iterator.
+// Elements are duplicates if f returns equal values for them.
+// If window is non-positive, all elements are yielded.
+// UniqWindowFunc requires space complexity of O(window).
+func UniqWindowFunc[T any, U comparable](i *Iterator[T], f func(T) U, window int) *Iterator[T] {
+	window = max(window, 0)
+	var (
+		keys   = make([]U, 0, min(window, i.preallocSize(allocOptions{})))
+		counts = make(map[U]int, cap(keys))
+		pos    int
+		zero   T
+	)
+	return New(func() (T, bool) {
+		for {
+			v, ok := i.f()
+			if !ok {
+				return zero, false
+			}
+			if window == 0 {
+				return v, true
+			}
+			key := f(v)
+			met := counts[key] > 0
+			if len(keys) < window {
+				keys = append(keys, key)
+			} else {
+				oldKey := keys[pos]
+				if counts[oldKey]--; counts[oldKey] == 0 {
+					delete(counts, oldKey)
+				}
+				keys[pos] = key
+				pos = (pos + 1) % window
+			}
+			counts[key]++
+			if !met {
+				return v, true
+			}
+		}
+	}).onClose(i.Close).withHint(func() (int, int) {
+		lower, upper := i.sizeHint()
+		return min(lower, 1), upper
+	})
+}
+
+// UniqLRU creates new iterator that yields unique elements of source iterator,
+// remembering at most capacity least recently met elements.
+// Element that was forgotten is yielded again when it is met.
+// If capacity is non-positive, all elements are yielded.
+// UniqLRU requires space complexity of O(capacity).
+func UniqLRU[T comparable](i *Iterator[T], capacity int) *Iterator[T] {
+	return UniqLRUFunc(i, identity[T], capacity)
+}
+
+// UniqLRUFunc creates new iterator that yields unique elements of source iterator,
+// remembering at most capacity least recently met elements.
+// Uniqueness of elements is defined by return value from f.
+// Element that was forgotten is yielded again when it is met.
+// If capacity is non-positive, all elements are yielded.
+// UniqLRUFunc requires space complexity of O(capacity).
+func UniqLRUFunc[T any, U comparable](i *Iterator[T], f func(T) U, capacity int) *Iterator[T] {
+	var (
+		recent   = list.New()
+		elements = make(map[U]*list.Element)
+		zero     T
+	)
+	return New(func() (T, bool) {
+		for {
+			v, ok := i.f()
+			if !ok {
+				return zero, false
+			}
+			if capacity <= 0 {
+				return v, true
+			}
+			key := f(v)
+			if elem, met := elements[key]; met {
+				recent.MoveToFront(elem)
+				continue
+			}
+			elements[key] = recent.PushFront(key)
+			if recent.Len() > capacity {
+				oldest := recent.Back()
+				recent.Remove(oldest)
+				delete(elements, oldest.Value.(U))
+			}
+			return v, true
+		}
+	}).onClose(i.Close).withHint(func() (int, int) {
+		lower, upper := i.sizeHint()
+		return min(lower, 1), upper
+	})
+}
+
+// UniqApproxFunc creates new iterator that yields approximately unique elements of source iterator
+// using Bloom filter sized for capacity elements with false-positive rate fpRate.
+// Elements are duplicates if hash returns equal values for them.
+// False positive means that unique element is considered as already met and is not yielded,
+// while duplicates are never yielded.
+// If more than capacity unique elements are met, actual false-positive rate grows.
+// If capacity is non-positive, it is considered to be 1.
+// If fpRate is not in range (0, 1), false-positive rate of 0.01 is used.
+// UniqApproxFunc requires space complexity of O(capacity * log(1/fpRate)) bits.
+func UniqApproxFunc[T any](i *Iterator[T], hash func(T) uint64, capacity int, fpRate float64) *Iterator[T] {
+	filter := newBloomFilter(capacity, fpRate)
+	var zero T
+	return New(func() (T, bool) {
+		for {
+			v, ok := i.f()
+			if !ok {
+				return zero, false
+			}
+			if filter.add(hash(v)) {
+				return v, true
+			}
+		}
+	}).onClose(i.Close).withHint(func() (int, int) {
+		lower, upper := i.sizeHint()
+		return min(lower, 1), upper
+	})
+}
+
+// bloomFilter is a probabilistic set of hashes.
+type bloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes int
+}
+
+func newBloomFilter(capacity int, fpRate float64) *bloomFilter {
+	const defaultFPRate = 0.01
+	capacity = max(capacity, 1)
+	if !(fpRate > 0 && fpRate < 1) {
+		fpRate = defaultFPRate
+	}
+	size := math.Ceil(-float64(capacity) * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	hashes := max(int(math.Round(size/float64(capacity)*math.Ln2)), 1)
+	return &bloomFilter{
+		bits:   make([]uint64, (uint64(size)+63)/64),
+		size:   uint64(size),
+		hashes: hashes,
+	}
+}
+
+// add adds hash to the filter, returning false if the hash
+// is probably already in the filter.
+func (b *bloomFilter) add(hash uint64) bool {
+	h1, h2 := hash, mix64(hash)|1
+	var added bool
+	for k := 0; k < b.hashes; k++ {
+		pos := (h1 + uint64(k)*h2) % b.size
+		word, mask := pos/64, uint64(1)<<(pos%64)
+		if b.bits[word]&mask == 0 {
+			b.bits[word] |= mask
+			added = true
+		}
+	}
+	return added
+}
+
+// mix64 is a finalizer of SplitMix64 generator, used to derive
+// independent hash from the given one.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func identity[T any](v T) T {
+	return v
+}
@@ -0,0 +1,14 @@
+//go:build go1.24
+
+package itertools
+
+import "hash/maphash"
+
+// UniqApprox works like UniqApproxFunc (see UniqApproxFunc), but elements are hashed
+// with hash/maphash, so UniqApprox requires Go 1.24 or newer.
+func UniqApprox[T comparable](i *Iterator[T], capacity int, fpRate float64) *Iterator[T] {
+	seed := maphash.MakeSeed()
+	return UniqApproxFunc(i, func(v T) uint64 {
+		return maphash.Comparable(seed, v)
+	}, capacity, fpRate)
+}
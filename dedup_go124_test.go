@@ -0,0 +1,32 @@
+//go:build go1.24
+
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"testing"
+)
+
+func TestUniqApprox(t *testing.T) {
+	const n = 10000
+	source := itertools.Chain(
+		itertools.Range(0, n, 1),
+		itertools.Range(0, n, 1),
+	)
+
+	result := itertools.UniqApprox(source, n, 0.01).Collect()
+
+	if len(result) > n {
+		t.Errorf("expected no duplicates, got %d elements", len(result))
+	}
+	if minimal := n * 97 / 100; len(result) < minimal {
+		t.Errorf("expected at least %d elements, got %d", minimal, len(result))
+	}
+	seen := make(map[int]struct{}, len(result))
+	for _, v := range result {
+		if _, ok := seen[v]; ok {
+			t.Errorf("duplicate element %d", v)
+		}
+		seen[v] = struct{}{}
+	}
+}
@@ -0,0 +1,110 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"strings"
+	"testing"
+)
+
+func TestDedup(t *testing.T) {
+	t.Run("dedup", func(t *testing.T) {
+		result := itertools.Dedup(itertools.NewSliceIterator([]int{1, 1, 2, 3, 3, 3, 1, 2, 2})).Collect()
+
+		expected := []int{1, 2, 3, 1, 2}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("dedup func", func(t *testing.T) {
+		result := itertools.DedupFunc(
+			itertools.NewSliceIterator([]string{"a", "A", "b", "B", "b", "a"}),
+			strings.ToLower,
+		).Collect()
+
+		expected := []string{"a", "b", "a"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("uniq window", func(t *testing.T) {
+		result := itertools.UniqWindow(
+			itertools.NewSliceIterator([]int{1, 2, 1, 3, 4, 1, 2, 2, 5, 6, 2}),
+			2,
+		).Collect()
+
+		expected := []int{1, 2, 3, 4, 1, 2, 5, 6, 2}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zero uniq window", func(t *testing.T) {
+		result := itertools.UniqWindow(itertools.NewSliceIterator([]int{1, 1, 1}), 0).Collect()
+
+		expected := []int{1, 1, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("uniq window func", func(t *testing.T) {
+		result := itertools.UniqWindowFunc(
+			itertools.NewSliceIterator([]int{10, 21, 30, 42, 51}),
+			func(n int) int { return n % 10 },
+			2,
+		).Collect()
+
+		expected := []int{10, 21, 42, 51}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("uniq lru", func(t *testing.T) {
+		result := itertools.UniqLRU(
+			itertools.NewSliceIterator([]int{1, 2, 1, 3, 2, 4, 1, 3}),
+			2,
+		).Collect()
+
+		expected := []int{1, 2, 3, 2, 4, 1, 3}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("uniq lru refreshes recently met elements", func(t *testing.T) {
+		result := itertools.UniqLRU(
+			itertools.NewSliceIterator([]int{1, 2, 1, 3, 1, 2}),
+			2,
+		).Collect()
+
+		expected := []int{1, 2, 3, 2}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("uniq approx func", func(t *testing.T) {
+		result := itertools.UniqApproxFunc(
+			itertools.NewSliceIterator([]string{"a", "b", "a", "c", "b"}),
+			func(s string) uint64 { return uint64(s[0]) },
+			10,
+			0.001,
+		).Collect()
+
+		expected := []string{"a", "b", "c"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package itertools
+
+// IsDoubleEnded reports if iterator can efficiently yield elements from the back
+// (e.g. iterators for slices and strings and iterators derived from them by Map or Filter).
+// Elements can be taken from the back of any iterator, but iterators that are not double-ended
+// have to buffer all remaining elements first.
+func (i *Iterator[T]) IsDoubleEnded() bool {
+	return i.back != nil
+}
+
+// NextBack proceeds iterator to the next element from the back, returning boolean value
+// to show that said element exists. The element is accessible with Elem.
+// Elements yielded by NextBack are not yielded by Next and vice versa.
+// If iterator is not double-ended, NextBack consumes all remaining elements into buffer,
+// so it never returns for infinite iterators.
+func (i *Iterator[T]) NextBack() bool {
+	if !i.canProceed {
+		return false
+	}
+	i.value, i.canProceed = i.nextBack()
+	return i.canProceed
+}
+
+// Rev produces new iterator that yields elements of source iterator in reversed order.
+// If source iterator is not double-ended, the reversed iterator buffers all remaining elements
+// on the first iteration, so it never yields elements of infinite iterators.
+func (i *Iterator[T]) Rev() *Iterator[T] {
+	return New(i.nextBack).
+		withBack(func() (T, bool) {
+			return i.f()
+		}).
+		onClose(i.Close).
+		withHint(i.sizeHint)
+}
+
+// Last returns the last element of iterator.
+// The returned boolean value shows if the element exists.
+// After call of Last iterator is exhausted.
+// For double-ended iterators Last takes O(1) time.
+func (i *Iterator[T]) Last() (T, bool) {
+	var last T
+	if !i.canProceed {
+		return last, false
+	}
+	if i.back != nil {
+		v, ok := i.back()
+		i.canProceed = false
+		return v, ok
+	}
+	var found bool
+	for i.Next() {
+		last, found = i.Elem(), true
+	}
+	return last, found
+}
+
+// NthBack returns n-th (starting from 0) of remaining elements of iterator from the back,
+// consuming all elements after it and the element itself.
+// The returned boolean value shows if the element exists.
+// If iterator is not double-ended, NthBack consumes all remaining elements into buffer.
+func (i *Iterator[T]) NthBack(n int) (T, bool) {
+	var zero T
+	if n < 0 {
+		return zero, false
+	}
+	for ; n > 0; n-- {
+		if !i.NextBack() {
+			return zero, false
+		}
+	}
+	if !i.NextBack() {
+		return zero, false
+	}
+	return i.Elem(), true
+}
+
+func (i *Iterator[T]) nextBack() (T, bool) {
+	if i.back == nil {
+		i.buffer()
+	}
+	return i.back()
+}
+
+// buffer collects all remaining elements of iterator into a slice,
+// making the iterator double-ended.
+func (i *Iterator[T]) buffer() {
+	elems := make([]T, 0, i.preallocSize(allocOptions{}))
+	for v, ok := i.f(); ok; v, ok = i.f() {
+		elems = append(elems, v)
+	}
+	buffered := NewSliceIterator(elems)
+	i.f, i.back, i.hint, i.skip = buffered.f, buffered.back, buffered.hint, buffered.skip
+}
+
+// withBack sets function yielding elements from the back of iterator.
+func (i *Iterator[T]) withBack(back func() (T, bool)) *Iterator[T] {
+	i.back = back
+	return i
+}
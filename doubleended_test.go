@@ -0,0 +1,217 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"slices"
+	"testing"
+)
+
+func TestDoubleEndedIterator(t *testing.T) {
+	t.Run("rev", func(t *testing.T) {
+		result := itertools.NewSliceIterator([]int{1, 2, 3, 4}).Rev().Collect()
+
+		expected := []int{4, 3, 2, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("double rev", func(t *testing.T) {
+		result := itertools.NewAsciiIterator("abc").Rev().Rev().Collect()
+
+		expected := []byte("abc")
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("utf8 rev", func(t *testing.T) {
+		result := string(itertools.NewUTF8Iterator("привет").Rev().Collect())
+
+		if expected := "тевирп"; result != expected {
+			t.Errorf("expected %q, got %q", expected, result)
+		}
+	})
+
+	t.Run("utf8 rev with invalid rune", func(t *testing.T) {
+		for _, s := range []string{"ab\xff", "ab\xffcd", "a\uFFFDb"} {
+			forward := itertools.NewUTF8Iterator(s).Collect()
+			slices.Reverse(forward)
+
+			result := itertools.NewUTF8Iterator(s).Rev().Collect()
+
+			if !sliceEqual(forward, result) {
+				t.Errorf("%q: expected %q, got %q", s, string(forward), string(result))
+			}
+		}
+	})
+
+	t.Run("next from both ends", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5})
+
+		var result []int
+		for step := 0; ; step++ {
+			var ok bool
+			if step%2 == 0 {
+				ok = i.Next()
+			} else {
+				ok = i.NextBack()
+			}
+			if !ok {
+				break
+			}
+			result = append(result, i.Elem())
+		}
+
+		expected := []int{1, 5, 2, 4, 3}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("last", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{1, 2, 3})
+		if result, ok := i.Last(); !ok || result != 3 {
+			t.Errorf("expected %d, got %d", 3, result)
+		}
+		if i.Next() {
+			t.Errorf("did not expect elements after Last; elem: %d", i.Elem())
+		}
+	})
+
+	t.Run("last of generic iterator", func(t *testing.T) {
+		i := itertools.New(fibonacciYielder(100))
+		if result, ok := i.Last(); !ok || result != 89 {
+			t.Errorf("expected %d, got %d", 89, result)
+		}
+	})
+
+	t.Run("last of empty iterator", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{})
+		if result, ok := i.Last(); ok {
+			t.Errorf("did not expect to find element; found %d", result)
+		}
+	})
+
+	t.Run("nth back", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5})
+		if result, ok := i.NthBack(1); !ok || result != 4 {
+			t.Errorf("expected %d, got %d", 4, result)
+		}
+		if result, ok := i.NthBack(2); !ok || result != 1 {
+			t.Errorf("expected %d, got %d", 1, result)
+		}
+		if result, ok := i.NthBack(0); ok {
+			t.Errorf("did not expect to find element; found %d", result)
+		}
+	})
+
+	t.Run("negative step", func(t *testing.T) {
+		result := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5, 6}).WithStep(-2).Collect()
+
+		expected := []int{6, 4, 2}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("map and filter", func(t *testing.T) {
+		var mapped int
+		i := itertools.Map(
+			itertools.NewSliceIterator([]int{1, 2, 3, 4, 5, 6}),
+			func(n int) int {
+				mapped++
+				return n * n
+			},
+		).Filter(func(n int) bool {
+			return n%2 == 0
+		})
+
+		if !i.IsDoubleEnded() {
+			t.Fatalf("expected iterator to be double-ended")
+		}
+		if !i.NextBack() || i.Elem() != 36 {
+			t.Errorf("expected %d, got %d", 36, i.Elem())
+		}
+		if mapped != 1 {
+			t.Errorf("expected mapper to be called %d times, got %d", 1, mapped)
+		}
+
+		result := i.Rev().Collect()
+		expected := []int{16, 4}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("enumerate", func(t *testing.T) {
+		i := itertools.Enumerate(itertools.NewAsciiIterator("abcd"))
+		i.Next()
+
+		result := i.Rev().Collect()
+
+		expected := []itertools.Enumeration[byte]{
+			{First: 'd', Second: 3},
+			{First: 'c', Second: 2},
+			{First: 'b', Second: 1},
+		}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		i := itertools.Zip(
+			itertools.NewSliceIterator([]int{1, 2, 3, 4, 5}),
+			itertools.NewAsciiIterator("abc"),
+		)
+
+		if !i.IsDoubleEnded() {
+			t.Fatalf("expected iterator to be double-ended")
+		}
+
+		result := i.Rev().Collect()
+
+		expected := []itertools.Pair[int, byte]{
+			{First: 3, Second: 'c'},
+			{First: 2, Second: 'b'},
+			{First: 1, Second: 'a'},
+		}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zip with non-double-ended", func(t *testing.T) {
+		i := itertools.Zip(
+			itertools.NewSliceIterator([]int{1, 2, 3}),
+			itertools.New(fibonacciYielder(100)),
+		)
+		if i.IsDoubleEnded() {
+			t.Errorf("did not expect iterator to be double-ended")
+		}
+	})
+
+	t.Run("rev of generic iterator", func(t *testing.T) {
+		i := itertools.New(fibonacciYielder(10))
+		if i.IsDoubleEnded() {
+			t.Errorf("did not expect iterator to be double-ended")
+		}
+		i.Next()
+
+		result := i.Rev().Collect()
+
+		expected := []int{8, 5, 3, 2, 1, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
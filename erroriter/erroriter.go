@@ -8,35 +8,83 @@ import (
 // ErrIterationStop indicates that iteration is over.
 var ErrIterationStop = errors.New("iteration stop")
 
-// ErrorIterator is an iterator yielding itertools.Pair of value of type T and error.
+// ErrorIterator is an iterator yielding Result containing either value of type T or error.
+//
+// Errors returned by the source of ErrorIterator are yielded as elements,
+// and the iteration continues. Fatal errors (see ErrFatal) terminate the iteration instead:
+// they are not yielded and can be retrieved with Err after the iteration is over.
 type ErrorIterator[T any] struct {
-	*itertools.Iterator[itertools.Pair[T, error]]
+	*itertools.Iterator[Result[T]]
+	err error
 }
 
 // New creates ErrorIterator that yields elements using function f.
-// Iterator yields elements until returned error is ErrIterationStop.
-func New[T any](f func() (T, error)) *ErrorIterator[T] {
-	i := itertools.New[itertools.Pair[T, error]](func() (itertools.Pair[T, error], bool) {
+// Iterator yields elements until returned error is ErrIterationStop or fatal error (see ErrFatal).
+// Function f is not called after the iteration is over.
+func New[T any](f func() (T, error), opts ...IterOption) *ErrorIterator[T] {
+	return NewWithClose(f, nil, opts...)
+}
+
+// NewWithClose creates ErrorIterator that yields elements using function f
+// and releases its resources with function closeFunc on Close.
+// Iterator yields elements until returned error is ErrIterationStop or fatal error (see ErrFatal).
+// Function f is not called after the iteration is over.
+func NewWithClose[T any](f func() (T, error), closeFunc func(), opts ...IterOption) *ErrorIterator[T] {
+	return newIterator(func() (T, any, error) {
 		v, err := f()
-		if errors.Is(err, ErrIterationStop) {
-			return itertools.Pair[T, error]{}, false
+		return v, nil, err
+	}, closeFunc, newOptions(opts))
+}
+
+// newIterator creates ErrorIterator yielding results of function f.
+// Besides value and error, f returns the source value which caused the error (if any)
+// to annotate the error with.
+func newIterator[T any](f func() (T, any, error), closeFunc func(), options iterOptions) *ErrorIterator[T] {
+	var (
+		i    = &ErrorIterator[T]{}
+		idx  int
+		done bool
+	)
+	yield := func() (Result[T], bool) {
+		if done {
+			return Result[T]{}, false
+		}
+		v, source, err := f()
+		switch {
+		case errors.Is(err, ErrIterationStop):
+			done = true
+			return Result[T]{}, false
+		case errors.Is(err, ErrFatal):
+			done = true
+			i.err = annotate(err, idx, source, options)
+			return Result[T]{}, false
 		}
-		return itertools.Pair[T, error]{
-			First:  v,
-			Second: err,
-		}, true
-	})
-	return &ErrorIterator[T]{Iterator: i}
+		err = annotate(err, idx, source, options)
+		idx++
+		return ResultOf(v, err), true
+	}
+	if closeFunc != nil {
+		i.Iterator = itertools.NewWithClose(yield, closeFunc)
+	} else {
+		i.Iterator = itertools.New(yield)
+	}
+	return i
 }
 
-// Result unpacks itertools.Pair element, returning value and error.
+// Err returns fatal error which terminated the iteration or nil
+// if the iteration is not over or ended normally.
+func (i *ErrorIterator[T]) Err() error {
+	return i.err
+}
+
+// Result unpacks current Result element, returning value and error.
 func (i *ErrorIterator[T]) Result() (T, error) {
-	v := i.Elem()
-	return v.First, v.Second
+	return i.Elem().Unwrap()
 }
 
 // CollectUntilError collects actual values into slice.
-// CollectUntilError returns this slice or first encountered error.
+// CollectUntilError returns this slice or first encountered error, including fatal one
+// (wrapped into ElementError if the iterator was created with WithElementIndex or WithElementValue).
 func (i *ErrorIterator[T]) CollectUntilError() ([]T, error) {
 	var results []T
 	for i.Next() {
@@ -46,5 +94,8 @@ func (i *ErrorIterator[T]) CollectUntilError() ([]T, error) {
 		}
 		results = append(results, v)
 	}
+	if err := i.Err(); err != nil {
+		return nil, err
+	}
 	return results, nil
 }
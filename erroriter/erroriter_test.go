@@ -1,6 +1,10 @@
 package erroriter_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/KSpaceer/itertools"
 	"github.com/KSpaceer/itertools/erroriter"
 	"strconv"
 	"testing"
@@ -55,3 +59,208 @@ func TestErrorIterator(t *testing.T) {
 		}
 	})
 }
+
+func TestElementError(t *testing.T) {
+	source := []string{"1", "2", "x", "4"}
+
+	t.Run("index and value", func(t *testing.T) {
+		_, err := erroriter.Map(
+			itertools.NewSliceIterator(source),
+			strconv.Atoi,
+			erroriter.WithElementValue(),
+		).CollectUntilError()
+
+		var elemErr *erroriter.ElementError
+		if !errors.As(err, &elemErr) {
+			t.Fatalf("expected ElementError, got %v", err)
+		}
+		if elemErr.Index != 2 || elemErr.Value != "x" {
+			t.Errorf("expected error at index 2 with value %q, got index %d with value %v",
+				"x", elemErr.Index, elemErr.Value)
+		}
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("expected error to wrap %v, got %v", strconv.ErrSyntax, err)
+		}
+	})
+
+	t.Run("index only", func(t *testing.T) {
+		var idx int
+		iter := erroriter.New(func() (int, error) {
+			if idx >= len(source) {
+				return 0, erroriter.ErrIterationStop
+			}
+			idx++
+			return strconv.Atoi(source[idx-1])
+		}, erroriter.WithElementIndex())
+
+		_, err := iter.CollectUntilError()
+
+		var elemErr *erroriter.ElementError
+		if !errors.As(err, &elemErr) {
+			t.Fatalf("expected ElementError, got %v", err)
+		}
+		if elemErr.Index != 2 || elemErr.Value != nil {
+			t.Errorf("expected error at index 2 without value, got index %d with value %v",
+				elemErr.Index, elemErr.Value)
+		}
+	})
+
+	t.Run("no annotation", func(t *testing.T) {
+		_, err := erroriter.Map(itertools.NewSliceIterator(source), strconv.Atoi).CollectUntilError()
+
+		var elemErr *erroriter.ElementError
+		if errors.As(err, &elemErr) {
+			t.Errorf("expected plain error, got %v", err)
+		}
+	})
+}
+
+func TestFatalError(t *testing.T) {
+	errBroken := errors.New("broken")
+
+	t.Run("terminates iteration", func(t *testing.T) {
+		var calls int
+		iter := erroriter.New(func() (int, error) {
+			calls++
+			switch calls {
+			case 1:
+				return 1, nil
+			case 2:
+				return 0, strconv.ErrSyntax
+			case 3:
+				return 0, erroriter.Fatal(errBroken)
+			default:
+				return calls, nil
+			}
+		})
+
+		results := iter.Collect()
+
+		if len(results) != 2 {
+			t.Errorf("expected 2 results, got %v", results)
+		}
+		if err := iter.Err(); !errors.Is(err, erroriter.ErrFatal) || !errors.Is(err, errBroken) {
+			t.Errorf("expected fatal %v, got %v", errBroken, err)
+		}
+		if iter.Next() || calls != 3 {
+			t.Errorf("expected source not to be called after fatal error, got %d calls", calls)
+		}
+	})
+
+	t.Run("collect until error", func(t *testing.T) {
+		var calls int
+		iter := erroriter.New(func() (int, error) {
+			calls++
+			if calls > 2 {
+				return 0, erroriter.Fatal(errBroken)
+			}
+			return calls, nil
+		})
+
+		result, err := iter.CollectUntilError()
+		if !errors.Is(err, errBroken) {
+			t.Errorf("expected %v, got %v with result %v", errBroken, err, result)
+		}
+	})
+
+	t.Run("normal end", func(t *testing.T) {
+		iter := erroriter.Map(itertools.NewSliceIterator([]string{"1", "x"}), strconv.Atoi)
+
+		if count := iter.Count(); count != 2 {
+			t.Errorf("expected 2 elements, got %d", count)
+		}
+		if err := iter.Err(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("map", func(t *testing.T) {
+		iter := erroriter.Map(
+			itertools.NewSliceIterator([]string{"1", "", "3"}),
+			func(s string) (int, error) {
+				if s == "" {
+					return 0, fmt.Errorf("%w: empty input", erroriter.ErrFatal)
+				}
+				return strconv.Atoi(s)
+			},
+			erroriter.WithElementIndex(),
+		)
+
+		if count := iter.Count(); count != 1 {
+			t.Errorf("expected 1 element, got %d", count)
+		}
+		var elemErr *erroriter.ElementError
+		if err := iter.Err(); !errors.As(err, &elemErr) || elemErr.Index != 1 {
+			t.Errorf("expected fatal error at index 1, got %v", err)
+		}
+	})
+
+	t.Run("map results", func(t *testing.T) {
+		var calls int
+		source := erroriter.New(func() (int, error) {
+			calls++
+			switch calls {
+			case 1:
+				return 1, nil
+			case 2:
+				return 0, strconv.ErrRange
+			default:
+				return 0, erroriter.Fatal(errBroken)
+			}
+		})
+
+		iter := erroriter.MapResults(source, func(n int) (string, error) {
+			return strconv.Itoa(n * 10), nil
+		})
+
+		results := iter.Collect()
+		if len(results) != 2 || results[0].Must() != "10" || !errors.Is(results[1].Err(), strconv.ErrRange) {
+			t.Errorf("unexpected results: %v", results)
+		}
+		if err := iter.Err(); !errors.Is(err, errBroken) {
+			t.Errorf("expected %v, got %v", errBroken, err)
+		}
+	})
+}
+
+func TestPrefetch(t *testing.T) {
+	errBroken := errors.New("broken")
+
+	t.Run("fatal error", func(t *testing.T) {
+		var calls int
+		iter := erroriter.Prefetch(erroriter.New(func() (int, error) {
+			calls++
+			switch {
+			case calls == 2:
+				return 0, strconv.ErrSyntax
+			case calls > 3:
+				return 0, erroriter.Fatal(errBroken)
+			}
+			return calls, nil
+		}), 2)
+
+		results := iter.Collect()
+
+		if len(results) != 3 || !errors.Is(results[1].Err(), strconv.ErrSyntax) {
+			t.Errorf("unexpected results: %v", results)
+		}
+		if err := iter.Err(); !errors.Is(err, errBroken) {
+			t.Errorf("expected %v, got %v", errBroken, err)
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		iter := erroriter.PrefetchContext(ctx, erroriter.New(func() (int, error) {
+			return 1, nil
+		}), 2)
+		defer iter.Close()
+
+		iter.Next()
+		cancel()
+
+		if _, err := iter.CollectUntilError(); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected %v, got %v", context.Canceled, err)
+		}
+	})
+}
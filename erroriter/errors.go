@@ -0,0 +1,77 @@
+package erroriter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFatal indicates that the source of ErrorIterator is broken and the iteration must be stopped.
+// Errors matching ErrFatal (checked with errors.Is) terminate ErrorIterator
+// and can be retrieved with ErrorIterator.Err. Use Fatal to make any error fatal.
+var ErrFatal = errors.New("fatal error")
+
+// Fatal wraps err into fatal error (see ErrFatal).
+// The returned error matches both ErrFatal and err with errors.Is.
+// If err is nil, Fatal returns nil.
+func Fatal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &fatalError{err: err}
+}
+
+type fatalError struct {
+	err error
+}
+
+func (e *fatalError) Error() string {
+	return "fatal: " + e.err.Error()
+}
+
+func (e *fatalError) Is(target error) bool {
+	return target == ErrFatal
+}
+
+func (e *fatalError) Unwrap() error {
+	return e.err
+}
+
+// ElementError is an error caused by the element of ErrorIterator.
+// ElementError carries index of the element and, optionally, the source value
+// (see WithElementIndex and WithElementValue).
+// ElementError unwraps to the original error, so errors.Is and errors.As
+// can be used to inspect it.
+type ElementError struct {
+	// Index is the index of the element (starting from 0).
+	Index int
+	// Value is the source value which caused the error or nil if it was not recorded.
+	Value any
+	// Err is the original error.
+	Err error
+}
+
+func (e *ElementError) Error() string {
+	if e.Value != nil {
+		return fmt.Sprintf("element %d (%v): %v", e.Index, e.Value, e.Err)
+	}
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the original error.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// annotate wraps non-nil err into ElementError if it is required by options
+// and err is not already annotated.
+func annotate(err error, idx int, value any, options iterOptions) error {
+	var elemErr *ElementError
+	if err == nil || !options.annotateIndex || errors.As(err, &elemErr) {
+		return err
+	}
+	elemErr = &ElementError{Index: idx, Err: err}
+	if options.annotateValue {
+		elemErr.Value = value
+	}
+	return elemErr
+}
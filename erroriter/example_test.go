@@ -1,10 +1,13 @@
 package erroriter_test
 
 import (
+	"errors"
 	"fmt"
 	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/clock"
 	"github.com/KSpaceer/itertools/erroriter"
 	"strconv"
+	"time"
 )
 
 func ExampleErrorIterator_Result() {
@@ -103,3 +106,109 @@ func ExampleMap() {
 	// 4
 	// got error
 }
+
+func ExampleResult() {
+	results := []erroriter.Result[int]{
+		erroriter.ResultOf(strconv.Atoi("10")),
+		erroriter.ResultOf(strconv.Atoi("ten")),
+	}
+
+	for _, r := range results {
+		fmt.Println(r.Map(func(n int) int { return n * 2 }).OrElse(-1), r.IsOk())
+	}
+	// Output:
+	// 20 true
+	// -1 false
+}
+
+func ExampleWithElementValue() {
+	lines := []string{"10", "20", "thirty"}
+
+	_, err := erroriter.Map(
+		itertools.NewSliceIterator(lines),
+		strconv.Atoi,
+		erroriter.WithElementValue(),
+	).CollectUntilError()
+
+	fmt.Println(err)
+	// Output:
+	// element 2 (thirty): strconv.Atoi: parsing "thirty": invalid syntax
+}
+
+func ExampleFatal() {
+	pages := []string{"1", "oops", "3", "", "5"}
+	var idx int
+
+	iter := erroriter.New(func() (int, error) {
+		if idx >= len(pages) {
+			return 0, erroriter.ErrIterationStop
+		}
+		page := pages[idx]
+		idx++
+		if page == "" {
+			return 0, erroriter.Fatal(errors.New("connection lost"))
+		}
+		return strconv.Atoi(page)
+	})
+
+	for iter.Next() {
+		v, err := iter.Result()
+		if err != nil {
+			fmt.Println("skipping bad page")
+			continue
+		}
+		fmt.Println(v)
+	}
+	fmt.Println(iter.Err())
+	// Output:
+	// 1
+	// skipping bad page
+	// 3
+	// fatal: connection lost
+}
+
+func ExamplePartition() {
+	values, errs := erroriter.Partition(erroriter.Map(
+		itertools.NewSliceIterator([]string{"1", "two", "3"}),
+		strconv.Atoi,
+	))
+
+	fmt.Println(values.Collect())
+	fmt.Println(errs.Count())
+	// Output:
+	// [1 3]
+	// 1
+}
+
+func ExampleTimeoutEach() {
+	// fake clock makes the example independent of scheduling:
+	// the time passes only when the slow request advances it
+	fake := clock.NewFake(time.Now())
+	release := make(chan struct{})
+	defer close(release)
+
+	iter := erroriter.Map(
+		itertools.NewSliceIterator([]string{"fast", "slow"}),
+		erroriter.TimeoutEach(func(request string) (string, error) {
+			if request == "slow" {
+				// wait for the timeout timer and let it fire
+				fake.BlockUntil(1)
+				fake.Advance(time.Second)
+				<-release
+			}
+			return request + " done", nil
+		}, 100*time.Millisecond, erroriter.WithClock(fake)),
+	)
+
+	for iter.Next() {
+		v, err := iter.Result()
+		if err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Println(v)
+		}
+	}
+	// Output:
+	// fast done
+	// element timeout: exceeded 100ms
+}
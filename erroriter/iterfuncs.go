@@ -4,12 +4,45 @@ import "github.com/KSpaceer/itertools"
 
 // Map creates new ErrorIterator which contains elements of type U
 // produced by applying mapper to elements of source iterator.
-func Map[T, U any](i *itertools.Iterator[T], mapper func(T) (U, error)) *ErrorIterator[U] {
-	var zero U
-	return New(func() (U, error) {
+// If mapper returns fatal error (see ErrFatal), the iteration is terminated.
+// With option WithElementValue returned errors carry the source element which caused the error.
+func Map[T, U any](i *itertools.Iterator[T], mapper func(T) (U, error), opts ...IterOption) *ErrorIterator[U] {
+	return newIterator(func() (U, any, error) {
 		if !i.Next() {
-			return zero, ErrIterationStop
+			var zero U
+			return zero, nil, ErrIterationStop
 		}
-		return mapper(i.Elem())
-	})
+		elem := i.Elem()
+		v, err := mapper(elem)
+		if err != nil {
+			return v, elem, err
+		}
+		return v, nil, nil
+	}, i.Close, newOptions(opts))
+}
+
+// MapResults creates new ErrorIterator which contains elements of type U
+// produced by applying mapper to successful elements of source ErrorIterator.
+// Errors of source iterator are yielded as is, and fatal error of source iterator
+// terminates the returned iterator and is available with its Err.
+// If mapper returns fatal error (see ErrFatal), the iteration is terminated as well.
+func MapResults[T, U any](i *ErrorIterator[T], mapper func(T) (U, error), opts ...IterOption) *ErrorIterator[U] {
+	return newIterator(func() (U, any, error) {
+		var zero U
+		if !i.Next() {
+			if err := i.Err(); err != nil {
+				return zero, nil, err
+			}
+			return zero, nil, ErrIterationStop
+		}
+		elem, err := i.Result()
+		if err != nil {
+			return zero, nil, err
+		}
+		v, err := mapper(elem)
+		if err != nil {
+			return v, elem, err
+		}
+		return v, nil, nil
+	}, i.Close, newOptions(opts))
 }
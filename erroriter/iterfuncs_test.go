@@ -10,12 +10,12 @@ import (
 
 func TestMap(t *testing.T) {
 	s := []string{"1", "2", "-2", "xnqwe", "5"}
-	collected := []itertools.Pair[int, error]{
-		{1, nil},
-		{2, nil},
-		{-2, nil},
-		{0, strconv.ErrSyntax},
-		{5, nil},
+	collected := []erroriter.Result[int]{
+		erroriter.Ok(1),
+		erroriter.Ok(2),
+		erroriter.Ok(-2),
+		erroriter.Err[int](strconv.ErrSyntax),
+		erroriter.Ok(5),
 	}
 
 	result := erroriter.Map(
@@ -28,7 +28,9 @@ func TestMap(t *testing.T) {
 	}
 
 	for i := range collected {
-		if !errors.Is(result[i].Second, collected[i].Second) || result[i].First != collected[i].First {
+		v, err := result[i].Unwrap()
+		expectedV, expectedErr := collected[i].Unwrap()
+		if !errors.Is(err, expectedErr) || v != expectedV {
 			t.Errorf("expected %v, got %v", collected, result)
 		}
 	}
@@ -0,0 +1,35 @@
+package erroriter
+
+type iterOptions struct {
+	annotateIndex bool
+	annotateValue bool
+}
+
+// IterOption allows to configure ErrorIterator.
+type IterOption func(options *iterOptions)
+
+// WithElementIndex makes ErrorIterator wrap every yielded error into ElementError
+// carrying the index (starting from 0) of the element which caused the error.
+func WithElementIndex() IterOption {
+	return func(o *iterOptions) {
+		o.annotateIndex = true
+	}
+}
+
+// WithElementValue works like WithElementIndex (see WithElementIndex),
+// but ElementError also carries the source value which caused the error.
+// The source value is available only for iterators created by Map.
+func WithElementValue() IterOption {
+	return func(o *iterOptions) {
+		o.annotateIndex = true
+		o.annotateValue = true
+	}
+}
+
+func newOptions(opts []IterOption) iterOptions {
+	var options iterOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
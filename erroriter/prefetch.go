@@ -0,0 +1,36 @@
+package erroriter
+
+import (
+	"context"
+	"github.com/KSpaceer/itertools"
+)
+
+// Prefetch creates new ErrorIterator that yields elements of source ErrorIterator,
+// reading them in background goroutine into buffer of size n ahead of the consumer
+// (see itertools.Prefetch). Fatal error of source iterator is available
+// with Err of returned iterator.
+func Prefetch[T any](i *ErrorIterator[T], n int) *ErrorIterator[T] {
+	return PrefetchContext(context.Background(), i, n)
+}
+
+// PrefetchContext works like Prefetch (see Prefetch), but also stops the iteration
+// when ctx is done. In this case the error of ctx is returned by Err as fatal error.
+func PrefetchContext[T any](ctx context.Context, i *ErrorIterator[T], n int) *ErrorIterator[T] {
+	prefetched := itertools.PrefetchContext(ctx, i.Iterator, n)
+	return newIterator(func() (T, any, error) {
+		if !prefetched.Next() {
+			var zero T
+			// ctx is checked first: if it is done, the source may still be read
+			// by background goroutine.
+			if err := ctx.Err(); err != nil {
+				return zero, nil, Fatal(err)
+			}
+			if err := i.Err(); err != nil {
+				return zero, nil, err
+			}
+			return zero, nil, ErrIterationStop
+		}
+		v, err := prefetched.Elem().Unwrap()
+		return v, nil, err
+	}, prefetched.Close, iterOptions{})
+}
@@ -0,0 +1,103 @@
+package erroriter
+
+import (
+	"fmt"
+	"github.com/KSpaceer/itertools"
+)
+
+// Result is an outcome of fallible operation: it contains either a value or an error.
+// The zero value of Result contains zero value of T and no error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates successful Result containing value v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err creates failed Result containing error err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultOf creates Result from value and error returned by fallible function.
+func ResultOf[T any](v T, err error) Result[T] {
+	return Result[T]{
+		value: v,
+		err:   err,
+	}
+}
+
+// FromPair creates Result from itertools.Pair of value and error.
+func FromPair[T any](p itertools.Pair[T, error]) Result[T] {
+	return ResultOf(p.Unpack())
+}
+
+// Unwrap returns value and error of Result.
+func (r Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// Must returns value of Result, panicking if Result contains error.
+func (r Result[T]) Must() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("erroriter: Must called on failed Result: %v", r.err))
+	}
+	return r.value
+}
+
+// Err returns error of Result or nil if Result is successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// IsOk returns true if Result contains no error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// OrElse returns value of Result or v if Result contains error.
+func (r Result[T]) OrElse(v T) T {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns Result containing result of f applied to value of successful Result.
+// If Result contains error, f is not called and the error is kept.
+// To change type of value use MapResult.
+func (r Result[T]) Map(f func(T) T) Result[T] {
+	return MapResult(r, f)
+}
+
+// Pair converts Result into itertools.Pair of value and error.
+func (r Result[T]) Pair() itertools.Pair[T, error] {
+	return itertools.Pair[T, error]{
+		First:  r.value,
+		Second: r.err,
+	}
+}
+
+// MapResult returns Result containing result of f applied to value of successful Result r.
+// If r contains error, f is not called and the error is kept.
+func MapResult[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// FromPairs creates ErrorIterator yielding elements of iterator of itertools.Pair
+// of value and error as Results.
+func FromPairs[T any](i *itertools.Iterator[itertools.Pair[T, error]]) *ErrorIterator[T] {
+	return &ErrorIterator[T]{Iterator: itertools.Map(i, FromPair[T])}
+}
+
+// ToPairs creates iterator yielding elements of ErrorIterator as itertools.Pair
+// of value and error.
+func ToPairs[T any](i *ErrorIterator[T]) *itertools.Iterator[itertools.Pair[T, error]] {
+	return itertools.Map(i.Iterator, Result[T].Pair)
+}
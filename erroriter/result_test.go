@@ -0,0 +1,78 @@
+package erroriter_test
+
+import (
+	"errors"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/erroriter"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	errTest := errors.New("test")
+
+	t.Run("ok", func(t *testing.T) {
+		r := erroriter.Ok(2)
+
+		if v, err := r.Unwrap(); v != 2 || err != nil {
+			t.Errorf("expected (2, nil), got (%d, %v)", v, err)
+		}
+		if !r.IsOk() || r.Err() != nil {
+			t.Errorf("expected successful Result")
+		}
+		if v := r.Must(); v != 2 {
+			t.Errorf("expected 2, got %d", v)
+		}
+		if v := r.OrElse(5); v != 2 {
+			t.Errorf("expected 2, got %d", v)
+		}
+		if v := r.Map(func(n int) int { return n * 3 }).Must(); v != 6 {
+			t.Errorf("expected 6, got %d", v)
+		}
+		if v := erroriter.MapResult(r, strconv.Itoa).Must(); v != "2" {
+			t.Errorf("expected %q, got %q", "2", v)
+		}
+	})
+
+	t.Run("err", func(t *testing.T) {
+		r := erroriter.Err[int](errTest)
+
+		if _, err := r.Unwrap(); !errors.Is(err, errTest) {
+			t.Errorf("expected %v, got %v", errTest, err)
+		}
+		if r.IsOk() || !errors.Is(r.Err(), errTest) {
+			t.Errorf("expected failed Result")
+		}
+		if v := r.OrElse(5); v != 5 {
+			t.Errorf("expected 5, got %d", v)
+		}
+		if err := erroriter.MapResult(r, strconv.Itoa).Err(); !errors.Is(err, errTest) {
+			t.Errorf("expected %v, got %v", errTest, err)
+		}
+
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected Must to panic")
+			}
+		}()
+		r.Must()
+	})
+
+	t.Run("pairs conversion", func(t *testing.T) {
+		pairs := []itertools.Pair[int, error]{
+			{First: 1},
+			{Second: errTest},
+		}
+
+		result := erroriter.ToPairs(erroriter.FromPairs(itertools.NewSliceIterator(pairs))).Collect()
+
+		if len(result) != len(pairs) {
+			t.Fatalf("expected %v, got %v", pairs, result)
+		}
+		for i := range pairs {
+			if result[i] != pairs[i] {
+				t.Errorf("expected %v, got %v", pairs, result)
+			}
+		}
+	})
+}
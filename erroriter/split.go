@@ -0,0 +1,129 @@
+package erroriter
+
+import (
+	"github.com/KSpaceer/itertools"
+	"sync"
+)
+
+// IgnoreErrors creates iterator that yields only successful values of ErrorIterator,
+// skipping errors. Fatal error of ErrorIterator is available with its Err.
+func IgnoreErrors[T any](i *ErrorIterator[T]) *itertools.Iterator[T] {
+	return SplitErrors(i, nil)
+}
+
+// OnlyErrors creates iterator that yields only errors of ErrorIterator, skipping successful values.
+// Fatal error of ErrorIterator (see ErrFatal) is yielded as the last element.
+func OnlyErrors[T any](i *ErrorIterator[T]) *itertools.Iterator[error] {
+	var fatalReported bool
+	return itertools.NewWithClose(func() (error, bool) {
+		for i.Next() {
+			if err := i.Elem().Err(); err != nil {
+				return err, true
+			}
+		}
+		if err := i.Err(); err != nil && !fatalReported {
+			fatalReported = true
+			return err, true
+		}
+		return nil, false
+	}, i.Close)
+}
+
+// SplitErrors creates iterator that yields successful values of ErrorIterator
+// and passes errors to function sink (e.g. to log them or send them to dead-letter queue).
+// Fatal error of ErrorIterator (see ErrFatal) is passed to sink after the last value.
+// If sink is nil, errors are skipped.
+func SplitErrors[T any](i *ErrorIterator[T], sink func(error)) *itertools.Iterator[T] {
+	var fatalReported bool
+	return itertools.NewWithClose(func() (T, bool) {
+		for i.Next() {
+			v, err := i.Result()
+			if err == nil {
+				return v, true
+			}
+			if sink != nil {
+				sink(err)
+			}
+		}
+		if err := i.Err(); err != nil && sink != nil && !fatalReported {
+			fatalReported = true
+			sink(err)
+		}
+		var zero T
+		return zero, false
+	}, i.Close)
+}
+
+// Partition splits ErrorIterator into iterator of successful values and iterator of errors.
+// Both iterators share ErrorIterator lazily: elements of ErrorIterator are read on demand,
+// and elements belonging to the other iterator are buffered until it reads them.
+// Thus, consuming only one of iterators requires space complexity of O(n).
+// Fatal error of ErrorIterator (see ErrFatal) is yielded as the last element of errors iterator.
+// ErrorIterator is closed when both iterators are closed.
+func Partition[T any](i *ErrorIterator[T]) (*itertools.Iterator[T], *itertools.Iterator[error]) {
+	var (
+		values       []T
+		errs         []error
+		valuesClosed bool
+		errsClosed   bool
+		fatalFetched bool
+	)
+	// fetch reads next element of ErrorIterator into corresponding buffer
+	// (unless the corresponding iterator is closed),
+	// returning false if ErrorIterator is exhausted.
+	fetch := func() bool {
+		if !i.Next() {
+			if err := i.Err(); err != nil && !fatalFetched {
+				fatalFetched = true
+				if !errsClosed {
+					errs = append(errs, err)
+				}
+				return true
+			}
+			return false
+		}
+		v, err := i.Result()
+		switch {
+		case err != nil && !errsClosed:
+			errs = append(errs, err)
+		case err == nil && !valuesClosed:
+			values = append(values, v)
+		}
+		return true
+	}
+
+	valuesIter := itertools.NewWithClose(func() (T, bool) {
+		for len(values) == 0 {
+			if !fetch() {
+				var zero T
+				return zero, false
+			}
+		}
+		v := values[0]
+		values = values[1:]
+		return v, true
+	}, sync.OnceFunc(func() {
+		valuesClosed, values = true, nil
+		if errsClosed {
+			i.Close()
+		}
+	}))
+
+	errsIter := itertools.NewWithClose(func() (error, bool) {
+		for len(errs) == 0 {
+			if !fetch() {
+				return nil, false
+			}
+		}
+		err := errs[0]
+		errs = errs[1:]
+		return err, true
+	}, sync.OnceFunc(func() {
+		errsClosed, errs = true, nil
+		if valuesClosed {
+			i.Close()
+		}
+	}))
+
+	return valuesIter, errsIter
+}
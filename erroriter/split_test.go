@@ -0,0 +1,95 @@
+package erroriter_test
+
+import (
+	"errors"
+	"github.com/KSpaceer/itertools/erroriter"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestSplitErrors(t *testing.T) {
+	source := []string{"1", "x", "3", "y", "5"}
+	errBroken := errors.New("broken")
+
+	newIter := func(fatal bool) *erroriter.ErrorIterator[int] {
+		var idx int
+		return erroriter.New(func() (int, error) {
+			if idx >= len(source) {
+				if fatal {
+					return 0, erroriter.Fatal(errBroken)
+				}
+				return 0, erroriter.ErrIterationStop
+			}
+			idx++
+			return strconv.Atoi(source[idx-1])
+		})
+	}
+
+	t.Run("ignore errors", func(t *testing.T) {
+		result := erroriter.IgnoreErrors(newIter(true)).Collect()
+
+		if expected := []int{1, 3, 5}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("only errors", func(t *testing.T) {
+		result := erroriter.OnlyErrors(newIter(true)).Collect()
+
+		if len(result) != 3 || !errors.Is(result[0], strconv.ErrSyntax) || !errors.Is(result[2], errBroken) {
+			t.Errorf("unexpected errors: %v", result)
+		}
+	})
+
+	t.Run("split errors", func(t *testing.T) {
+		var errs []error
+		result := erroriter.SplitErrors(newIter(true), func(err error) {
+			errs = append(errs, err)
+		}).Collect()
+
+		if expected := []int{1, 3, 5}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if len(errs) != 3 || !errors.Is(errs[2], erroriter.ErrFatal) {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+
+	t.Run("partition", func(t *testing.T) {
+		values, errs := erroriter.Partition(newIter(false))
+
+		firstErr, ok := errs.Nth(0)
+		if !ok || !errors.Is(firstErr, strconv.ErrSyntax) {
+			t.Errorf("expected syntax error, got %v", firstErr)
+		}
+
+		result := values.Collect()
+		if expected := []int{1, 3, 5}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+
+		if count := errs.Count(); count != 1 {
+			t.Errorf("expected 1 remaining error, got %d", count)
+		}
+	})
+
+	t.Run("partition close", func(t *testing.T) {
+		var closed bool
+		iter := erroriter.NewWithClose(func() (int, error) {
+			return 0, nil
+		}, func() { closed = true })
+
+		values, errs := erroriter.Partition(iter)
+
+		values.Close()
+		values.Close()
+		if closed {
+			t.Errorf("expected source to stay open while errors iterator is open")
+		}
+		errs.Close()
+		if !closed {
+			t.Errorf("expected source to be closed")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package erroriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/KSpaceer/itertools/clock"
+	"time"
+)
+
+type timeoutOptions struct {
+	clock clock.Clock
+}
+
+// TimeoutOption allows to configure TimeoutEach and TimeoutEachContext.
+type TimeoutOption func(options *timeoutOptions)
+
+// WithClock sets clock used to measure timeouts. By default, the real clock is used.
+// WithClock allows to test timeouts deterministically with clock.Fake.
+func WithClock(c clock.Clock) TimeoutOption {
+	return func(o *timeoutOptions) {
+		o.clock = c
+	}
+}
+
+// ErrTimeout indicates that mapper wrapped by TimeoutEach did not finish in time.
+var ErrTimeout = errors.New("element timeout")
+
+// TimeoutEach wraps mapper so that it fails with error matching ErrTimeout
+// if it does not return within duration d. The returned function is meant
+// to be passed to Map to produce error elements for slow elements.
+// The mapper is run in separate goroutine, which keeps running after the timeout
+// until the mapper returns; use TimeoutEachContext to stop the mapper on timeout.
+// Panic in mapper is propagated to the caller unless the timeout has already passed.
+// If d is not positive, mapper is returned as is.
+func TimeoutEach[T, U any](mapper func(T) (U, error), d time.Duration, opts ...TimeoutOption) func(T) (U, error) {
+	if d <= 0 {
+		return mapper
+	}
+	return TimeoutEachContext(func(_ context.Context, v T) (U, error) {
+		return mapper(v)
+	}, d, opts...)
+}
+
+// TimeoutEachContext works like TimeoutEach (see TimeoutEach), but passes to mapper context,
+// which is canceled on timeout.
+func TimeoutEachContext[T, U any](
+	mapper func(context.Context, T) (U, error),
+	d time.Duration,
+	opts ...TimeoutOption,
+) func(T) (U, error) {
+	var options timeoutOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	options.clock = clock.OrReal(options.clock)
+	type outcome struct {
+		result     Result[U]
+		panicValue any
+	}
+	return func(v T) (U, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if d <= 0 {
+			return mapper(ctx, v)
+		}
+
+		done := make(chan outcome, 1)
+		go func() {
+			var o outcome
+			defer func() {
+				o.panicValue = recover()
+				done <- o
+			}()
+			o.result = ResultOf(mapper(ctx, v))
+		}()
+
+		timer := options.clock.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case o := <-done:
+			if o.panicValue != nil {
+				panic(o.panicValue)
+			}
+			return o.result.Unwrap()
+		case <-timer.C():
+			var zero U
+			return zero, fmt.Errorf("%w: exceeded %v", ErrTimeout, d)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package erroriter_test
+
+import (
+	"context"
+	"errors"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/clock"
+	"github.com/KSpaceer/itertools/erroriter"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTimeoutEach(t *testing.T) {
+	t.Run("fast mapper", func(t *testing.T) {
+		result, err := erroriter.Map(
+			itertools.NewSliceIterator([]string{"1", "2"}),
+			erroriter.TimeoutEach(strconv.Atoi, time.Second),
+		).CollectUntilError()
+
+		if err != nil || !slices.Equal(result, []int{1, 2}) {
+			t.Errorf("expected [1 2], got %v with error %v", result, err)
+		}
+	})
+
+	t.Run("slow mapper", func(t *testing.T) {
+		fake := clock.NewFake(time.Time{})
+		release := make(chan struct{})
+		defer close(release)
+
+		mapper := erroriter.TimeoutEach(func(s string) (int, error) {
+			if s == "slow" {
+				<-release
+			}
+			return len(s), nil
+		}, time.Second, erroriter.WithClock(fake))
+
+		go func() {
+			fake.BlockUntil(1)
+			fake.Advance(time.Second)
+		}()
+
+		if _, err := mapper("slow"); !errors.Is(err, erroriter.ErrTimeout) {
+			t.Errorf("expected %v, got %v", erroriter.ErrTimeout, err)
+		}
+	})
+
+	t.Run("context canceled on timeout", func(t *testing.T) {
+		fake := clock.NewFake(time.Time{})
+		canceled := make(chan struct{})
+
+		mapper := erroriter.TimeoutEachContext(func(ctx context.Context, _ int) (int, error) {
+			<-ctx.Done()
+			close(canceled)
+			return 0, ctx.Err()
+		}, time.Second, erroriter.WithClock(fake))
+
+		go func() {
+			fake.BlockUntil(1)
+			fake.Advance(time.Second)
+		}()
+
+		if _, err := mapper(1); !errors.Is(err, erroriter.ErrTimeout) {
+			t.Errorf("expected %v, got %v", erroriter.ErrTimeout, err)
+		}
+		<-canceled
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		mapper := erroriter.TimeoutEach(func(int) (int, error) {
+			panic("mapper failure")
+		}, time.Hour)
+
+		defer func() {
+			if r := recover(); r != "mapper failure" {
+				t.Errorf("expected panic %q, got %v", "mapper failure", r)
+			}
+		}()
+		_, _ = mapper(1)
+		t.Errorf("expected panic")
+	})
+}
@@ -2,12 +2,16 @@ package itertools_test
 
 import (
 	"cmp"
+	"context"
 	"fmt"
 	"github.com/KSpaceer/itertools"
 	"math"
+	"math/rand/v2"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 )
 
@@ -335,6 +339,40 @@ func ExampleChain() {
 	// chained collected result: Hello World!
 }
 
+func ExampleInterleave() {
+	iter := itertools.Interleave(
+		itertools.NewSliceIterator([]int{1, 4, 6}),
+		itertools.NewSliceIterator([]int{2, 5}),
+		itertools.NewSliceIterator([]int{3}),
+	)
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [1 2 3 4 5 6]
+}
+
+func ExampleRoundRobin() {
+	iter := itertools.RoundRobin(
+		[]int{2, 1},
+		itertools.NewAsciiIterator("aaaaa"),
+		itertools.NewAsciiIterator("bbb"),
+	)
+
+	fmt.Println(string(iter.Collect()))
+	// Output:
+	// aabaabab
+}
+
+func ExampleIntersperse() {
+	words := []string{"Hello", "World", "!"}
+
+	iter := itertools.Intersperse(itertools.NewSliceIterator(words), " ")
+
+	fmt.Println(itertools.Sum(iter))
+	// Output:
+	// Hello World !
+}
+
 func ExampleZip() {
 	names := []string{"Bob", "John", "Michael", "Jenny"}
 	ages := []uint{31, 42, 17, 26}
@@ -688,3 +726,400 @@ func ExampleNewUTF8Iterator() {
 	// Output:
 	// chinese hieroglyphs: 世界
 }
+
+func ExampleProduct() {
+	iter := itertools.Product([]string{"x", "y"}, []int{1, 2})
+
+	for iter.Next() {
+		fmt.Println(iter.Elem().Unpack())
+	}
+	// Output:
+	// x 1
+	// x 2
+	// y 1
+	// y 2
+}
+
+func ExamplePermutations() {
+	iter := itertools.Permutations([]string{"A", "B", "C"}, 2)
+
+	for iter.Next() {
+		fmt.Println(iter.Elem())
+	}
+	// Output:
+	// [A B]
+	// [A C]
+	// [B A]
+	// [B C]
+	// [C A]
+	// [C B]
+}
+
+func ExampleCombinations() {
+	iter := itertools.Combinations([]string{"A", "B", "C", "D"}, 3)
+
+	for iter.Next() {
+		fmt.Println(iter.Elem())
+	}
+	// Output:
+	// [A B C]
+	// [A B D]
+	// [A C D]
+	// [B C D]
+}
+
+func ExamplePowerSet() {
+	iter := itertools.PowerSet([]int{1, 2, 3})
+
+	for iter.Next() {
+		fmt.Println(iter.Elem())
+	}
+	// Output:
+	// []
+	// [1]
+	// [2]
+	// [3]
+	// [1 2]
+	// [1 3]
+	// [2 3]
+	// [1 2 3]
+}
+
+func ExampleRange() {
+	fmt.Println(itertools.Range(0, 10, 2).Collect())
+	fmt.Println(itertools.Range(10, 0, -3).Collect())
+	fmt.Println(itertools.Range(0, 0.5, 0.1).Collect())
+	// Output:
+	// [0 2 4 6 8]
+	// [10 7 4 1]
+	// [0 0.1 0.2 0.30000000000000004 0.4]
+}
+
+func ExampleLinspace() {
+	fmt.Println(itertools.Linspace(1.0, 2.0, 5).Collect())
+	// Output:
+	// [1 1.25 1.5 1.75 2]
+}
+
+func ExampleUnfold() {
+	iter := itertools.Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 50
+	})
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [0 1 1 2 3 5 8 13 21 34]
+}
+
+func ExampleIterate() {
+	iter := itertools.Iterate("a", func(s string) string {
+		return s + "a"
+	})
+
+	fmt.Println(iter.Limit(4).Collect())
+	// Output:
+	// [a aa aaa aaaa]
+}
+
+func ExampleGenerate() {
+	iter := itertools.Generate(func(yield func(string) bool) {
+		for _, s := range []string{"a", "b", "c", "d"} {
+			if !yield(s) {
+				fmt.Println("producer stopped")
+				return
+			}
+		}
+	})
+
+	fmt.Println(iter.Limit(2).Collect())
+	iter.Close()
+	// Output:
+	// [a b]
+	// producer stopped
+}
+
+func ExampleIterator_SizeHint() {
+	iter := itertools.Map(
+		itertools.NewSliceIterator([]int{1, 2, 3, 4, 5}),
+		strconv.Itoa,
+	)
+	fmt.Println(iter.SizeHint())
+
+	iter = iter.Filter(func(s string) bool { return s != "3" })
+	fmt.Println(iter.SizeHint())
+
+	fmt.Println(itertools.New(func() (int, bool) { return 0, false }).SizeHint())
+	// Output:
+	// 5 5
+	// 0 5
+	// 0 -1
+}
+
+func ExampleIterator_Nth() {
+	iter := itertools.NewSliceIterator([]string{"a", "b", "c", "d", "e"})
+
+	fmt.Println(iter.Nth(2))
+	fmt.Println(iter.Nth(0))
+	fmt.Println(iter.Nth(5))
+	// Output:
+	// c true
+	// d true
+	//  false
+}
+
+func ExampleIterator_Rev() {
+	iter := itertools.NewUTF8Iterator("Hello, 世界!").Rev()
+
+	fmt.Println(string(iter.Collect()))
+	// Output:
+	// !界世 ,olleH
+}
+
+func ExampleIterator_NextBack() {
+	iter := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5})
+
+	iter.Next()
+	fmt.Println("front:", iter.Elem())
+	iter.NextBack()
+	fmt.Println("back:", iter.Elem())
+	fmt.Println("rest:", iter.Collect())
+	// Output:
+	// front: 1
+	// back: 5
+	// rest: [2 3 4]
+}
+
+func ExampleIterator_Last() {
+	iter := itertools.Map(
+		itertools.NewSliceIterator([]int{1, 2, 3, 4, 5}),
+		func(n int) int { return n * n },
+	)
+
+	fmt.Println(iter.Last())
+	// Output:
+	// 25 true
+}
+
+func ExampleSortedByKey() {
+	words := []string{"banana", "Cherry", "apple", "date"}
+
+	iter := itertools.SortedByKey(itertools.NewSliceIterator(words), strings.ToLower)
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [apple banana Cherry date]
+}
+
+func ExampleDescending() {
+	s := []int{3, 5, 1, 4, 2}
+
+	iter := itertools.NewSliceIterator(s).SortedBy(itertools.Descending(cmp.Compare[int]))
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [5 4 3 2 1]
+}
+
+func ExampleDedup() {
+	s := []int{1, 1, 2, 2, 2, 3, 1, 1}
+
+	iter := itertools.Dedup(itertools.NewSliceIterator(s))
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [1 2 3 1]
+}
+
+func ExampleUniqWindow() {
+	s := []string{"a", "b", "a", "c", "d", "a"}
+
+	iter := itertools.UniqWindow(itertools.NewSliceIterator(s), 2)
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [a b c d a]
+}
+
+func ExampleReservoirSample() {
+	rng := rand.New(rand.NewPCG(42, 42))
+
+	sample := itertools.ReservoirSample(itertools.Range(0, 1000, 1), 5, rng)
+
+	fmt.Println(sample)
+	// Output:
+	// [170 640 376 823 996]
+}
+
+func ExampleShuffled() {
+	rng := rand.New(rand.NewPCG(42, 42))
+
+	iter := itertools.Shuffled(itertools.NewSliceIterator([]string{"a", "b", "c", "d"}), rng)
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [c a b d]
+}
+
+func ExampleJoin() {
+	names := []itertools.Pair[int, string]{{First: 1, Second: "alice"}, {First: 2, Second: "bob"}}
+	ages := []itertools.Pair[int, int]{{First: 2, Second: 31}, {First: 1, Second: 25}}
+
+	iter := itertools.Join(
+		itertools.NewSliceIterator(names),
+		itertools.NewSliceIterator(ages),
+		func(p itertools.Pair[int, string]) int { return p.First },
+		func(p itertools.Pair[int, int]) int { return p.First },
+	)
+
+	for iter.Next() {
+		p := iter.Elem()
+		fmt.Println(p.First.Second, p.Second.Second)
+	}
+	// Output:
+	// alice 25
+	// bob 31
+}
+
+func ExampleUnion() {
+	a := []int{1, 2, 3, 2}
+	b := []int{4, 3, 5}
+
+	iter := itertools.Union(itertools.NewSliceIterator(a), itertools.NewSliceIterator(b))
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [1 2 3 4 5]
+}
+
+func ExampleIntersection() {
+	a := []string{"x", "y", "z"}
+	b := []string{"z", "x", "w"}
+
+	iter := itertools.Intersection(itertools.NewSliceIterator(a), itertools.NewSliceIterator(b))
+
+	fmt.Println(iter.Collect())
+	// Output:
+	// [x z]
+}
+
+func ExampleMaxOpt() {
+	empty := itertools.NewSliceIterator([]int{})
+	nonEmpty := itertools.NewSliceIterator([]int{-3, -1, -2})
+
+	fmt.Println(itertools.MaxOpt(empty).IsSome())
+	fmt.Println(itertools.MaxOpt(nonEmpty).OrElse(0))
+	// Output:
+	// false
+	// -1
+}
+
+func ExamplePrefetch() {
+	pages := itertools.Map(itertools.Range(1, 4, 1), func(n int) string {
+		// slow source imitation (e.g. fetching pages over network)
+		time.Sleep(time.Millisecond)
+		return "page " + strconv.Itoa(n)
+	})
+
+	iter := itertools.Prefetch(pages, 2)
+	defer iter.Close()
+
+	for iter.Next() {
+		fmt.Println(iter.Elem())
+	}
+	// Output:
+	// page 1
+	// page 2
+	// page 3
+}
+
+func ExampleDistribute() {
+	chans := itertools.Distribute(itertools.Range(1, 7, 1), 2, itertools.RoundRobinStrategy[int]())
+
+	sums := make([]int, len(chans))
+	var wg sync.WaitGroup
+	for idx, ch := range chans {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sums[idx] = itertools.Sum(itertools.NewChanIterator(ch))
+		}()
+	}
+	wg.Wait()
+
+	fmt.Println(sums)
+	// Output:
+	// [9 12]
+}
+
+func ExampleFanIn() {
+	producer := func(values ...string) <-chan string {
+		ch := make(chan string)
+		go func() {
+			defer close(ch)
+			for _, v := range values {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+
+	iter := itertools.FanIn(context.Background(), producer("a", "b"), producer("c"), producer("d", "e"))
+
+	fmt.Println(itertools.Sorted(iter).Collect())
+	// Output:
+	// [a b c d e]
+}
+
+func ExampleParallelReduce() {
+	sumOfSquares := itertools.ParallelReduce(
+		itertools.Range(1, 1001, 1),
+		4,
+		64,
+		0,
+		func(acc, n int) int { return acc + n*n },
+		func(a, b int) int { return a + b },
+	)
+
+	fmt.Println(sumOfSquares)
+	// Output:
+	// 333833500
+}
+
+func ExampleBatchedTimeout() {
+	events := make(chan string, 5)
+	for _, event := range []string{"a", "b", "c", "d", "e"} {
+		events <- event
+	}
+	close(events)
+
+	// full batches are returned without waiting, the last partial batch
+	// is returned when the channel is closed
+	iter := itertools.BatchedTimeout(events, 2, time.Second)
+
+	for iter.Next() {
+		fmt.Println(iter.Elem())
+	}
+	// Output:
+	// [a b]
+	// [c d]
+	// [e]
+}
+
+func ExampleThrottle() {
+	requests := itertools.Map(itertools.Range(1, 6, 1), func(n int) string {
+		return "request " + strconv.Itoa(n)
+	})
+
+	// at most 1000 requests per second with bursts of up to 2 requests
+	iter := itertools.Throttle(requests, 1000, 2)
+
+	for iter.Next() {
+		fmt.Println(iter.Elem())
+	}
+	// Output:
+	// request 1
+	// request 2
+	// request 3
+	// request 4
+	// request 5
+}
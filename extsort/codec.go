@@ -0,0 +1,54 @@
+package extsort
+
+import (
+	"encoding/gob"
+	"io"
+)
+
+// Encoder writes elements of type T to the underlying writer.
+type Encoder[T any] interface {
+	Encode(v T) error
+}
+
+// Decoder reads elements of type T from the underlying reader.
+// Decode returns io.EOF when there are no more elements.
+type Decoder[T any] interface {
+	Decode(v *T) error
+}
+
+// Codec creates encoders and decoders used to write sorted runs
+// to temporary files and to read them back.
+type Codec[T any] interface {
+	NewEncoder(w io.Writer) Encoder[T]
+	NewDecoder(r io.Reader) Decoder[T]
+}
+
+// GobCodec is Codec using encoding/gob package.
+// GobCodec is used by default.
+type GobCodec[T any] struct{}
+
+// NewEncoder creates gob encoder writing to w.
+func (GobCodec[T]) NewEncoder(w io.Writer) Encoder[T] {
+	return gobEncoder[T]{enc: gob.NewEncoder(w)}
+}
+
+// NewDecoder creates gob decoder reading from r.
+func (GobCodec[T]) NewDecoder(r io.Reader) Decoder[T] {
+	return gobDecoder[T]{dec: gob.NewDecoder(r)}
+}
+
+type gobEncoder[T any] struct {
+	enc *gob.Encoder
+}
+
+func (e gobEncoder[T]) Encode(v T) error {
+	return e.enc.Encode(v)
+}
+
+type gobDecoder[T any] struct {
+	dec *gob.Decoder
+}
+
+func (d gobDecoder[T]) Decode(v *T) error {
+	return d.dec.Decode(v)
+}
@@ -0,0 +1,3 @@
+// Package extsort provides external merge sort for iterators
+// containing more elements than can be kept in memory.
+package extsort
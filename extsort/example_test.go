@@ -0,0 +1,28 @@
+package extsort_test
+
+import (
+	"fmt"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/extsort"
+)
+
+func ExampleSorted() {
+	data := []int{8, 3, 5, 1, 9, 2, 7, 4, 6}
+
+	iter := extsort.Sorted(
+		itertools.NewSliceIterator(data),
+		extsort.WithRunSize(4),
+	)
+	defer iter.Close()
+
+	for iter.Next() {
+		fmt.Print(iter.Elem(), " ")
+	}
+	fmt.Println()
+
+	if err := iter.Err(); err != nil {
+		fmt.Println("got error:", err)
+	}
+	// Output:
+	// 1 2 3 4 5 6 7 8 9
+}
@@ -0,0 +1,414 @@
+package extsort
+
+import (
+	"bufio"
+	"cmp"
+	"container/heap"
+	"errors"
+	"fmt"
+	"github.com/KSpaceer/itertools"
+	"io"
+	"os"
+	"slices"
+)
+
+// Iterator is an iterator yielding elements of source iterator in sorted order.
+// Errors occurred while writing or reading temporary files stop the iteration
+// and can be retrieved with Err.
+type Iterator[T any] struct {
+	*itertools.Iterator[T]
+	err error
+}
+
+// Err returns error that stopped the iteration or nil if the iteration
+// is not over or was successful.
+func (i *Iterator[T]) Err() error {
+	return i.err
+}
+
+// Sorted creates new iterator that yields elements of source iterator in ascending order
+// using external merge sort (see SortedBy).
+func Sorted[T cmp.Ordered](i *itertools.Iterator[T], opts ...Option) *Iterator[T] {
+	return SortedBy(i, cmp.Compare[T], opts...)
+}
+
+// SortedBy creates new iterator that yields elements of source iterator in sorted order
+// defined by cmp, keeping the original order of equal elements.
+//
+// SortedBy keeps at most run size (see WithRunSize) elements in memory:
+// every run of elements is sorted and spilled to a temporary file with the codec (see WithCodec).
+// Sorted runs are lazily merged back while the elements are yielded.
+// At most max open runs (see WithMaxOpenRuns) temporary files are read at once:
+// if there are more runs, they are first merged in several passes into fewer larger runs.
+// If source iterator contains fewer elements than run size, no temporary files are created.
+//
+// SortedBy panics if the codec set with WithCodec has element type different from T.
+//
+// SortedBy is lazy: elements of source iterator are consumed on the first access
+// to the elements of returned iterator.
+// Temporary files are removed when the iteration is over or the iterator is closed,
+// so the iterator must be closed if it is not exhausted.
+func SortedBy[T any](i *itertools.Iterator[T], cmp func(T, T) int, opts ...Option) *Iterator[T] {
+	options := sortOptions{runSize: DefaultRunSize, maxOpenRuns: DefaultMaxOpenRuns}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	var codec Codec[T] = GobCodec[T]{}
+	if options.codec != nil {
+		c, ok := options.codec.(Codec[T])
+		if !ok {
+			var zero T
+			panic(fmt.Sprintf("extsort: codec of type %T does not match element type %T", options.codec, zero))
+		}
+		codec = c
+	}
+
+	s := &sorter[T]{
+		source:  i,
+		options: options,
+		codec:   codec,
+		runs:    runHeap[T]{cmp: cmp},
+	}
+	result := &Iterator[T]{}
+	result.Iterator = itertools.NewWithClose(func() (T, bool) {
+		v, ok, err := s.next()
+		if err != nil {
+			result.err = err
+			s.cleanup()
+		}
+		return v, ok
+	}, func() {
+		s.cleanup()
+		i.Close()
+	})
+	return result
+}
+
+type sorter[T any] struct {
+	source  *itertools.Iterator[T]
+	options sortOptions
+	codec   Codec[T]
+	started bool
+	runs    runHeap[T]
+	files   map[string]struct{}
+}
+
+func (s *sorter[T]) next() (T, bool, error) {
+	var zero T
+	if !s.started {
+		s.started = true
+		if err := s.split(); err != nil {
+			return zero, false, err
+		}
+	}
+	if s.runs.Len() == 0 {
+		s.cleanup()
+		return zero, false, nil
+	}
+	top := s.runs.runs[0]
+	v := top.head
+	ok, err := top.advance()
+	if err != nil {
+		return zero, false, err
+	}
+	if ok {
+		heap.Fix(&s.runs, 0)
+	} else {
+		heap.Pop(&s.runs)
+		if err := top.close(); err != nil {
+			return zero, false, err
+		}
+	}
+	return v, true, nil
+}
+
+// split consumes source iterator, dividing it into sorted runs
+// and preparing the runs for merging. Every full run is spilled to temporary file
+// before the next one is read, so at most run size elements are kept in memory.
+// The last incomplete run is kept in memory.
+// If there are more spilled runs than max open runs (see WithMaxOpenRuns),
+// they are merged in several passes into fewer larger runs.
+func (s *sorter[T]) split() (err error) {
+	var (
+		names []string
+		runs  []*run[T]
+		batch []T
+	)
+	defer func() {
+		if err != nil {
+			for _, r := range runs {
+				r.close()
+			}
+		}
+	}()
+	for {
+		// the buffer is reused, because full batches are spilled to disk
+		batch = batch[:0]
+		for len(batch) < s.options.runSize && s.source.Next() {
+			batch = append(batch, s.source.Elem())
+		}
+		slices.SortStableFunc(batch, s.runs.cmp)
+		if len(batch) < s.options.runSize {
+			break
+		}
+		name, err := s.spill(batch)
+		if err != nil {
+			return err
+		}
+		names = append(names, name)
+	}
+
+	for len(names) > s.options.maxOpenRuns {
+		if names, err = s.mergePass(names); err != nil {
+			return err
+		}
+	}
+
+	for idx, name := range names {
+		r, err := openRun(name, s.codec, idx)
+		if err != nil {
+			return err
+		}
+		runs = append(runs, r)
+	}
+	if len(batch) > 0 {
+		runs = append(runs, newMemoryRun(batch, len(runs)))
+	}
+	for _, r := range runs {
+		ok, err := r.advance()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Push(&s.runs, r)
+		} else if err := r.close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergePass merges every max open runs (see WithMaxOpenRuns) consecutive spilled runs
+// into a single run, keeping the order of runs. Merged temporary files are removed.
+func (s *sorter[T]) mergePass(names []string) ([]string, error) {
+	merged := make([]string, 0, (len(names)+s.options.maxOpenRuns-1)/s.options.maxOpenRuns)
+	for len(names) > 0 {
+		group := names[:min(s.options.maxOpenRuns, len(names))]
+		names = names[len(group):]
+		name, err := s.merge(group)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, name)
+	}
+	return merged, nil
+}
+
+// merge merges spilled runs into a single run stored in new temporary file
+// and removes temporary files of the merged runs.
+func (s *sorter[T]) merge(names []string) (string, error) {
+	runs := runHeap[T]{cmp: s.runs.cmp}
+	defer func() {
+		for _, r := range runs.runs {
+			r.close()
+		}
+	}()
+	for idx, name := range names {
+		r, err := openRun(name, s.codec, idx)
+		if err != nil {
+			return "", err
+		}
+		ok, err := r.advance()
+		if ok {
+			heap.Push(&runs, r)
+		} else {
+			r.close()
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	name, err := s.write(func(enc Encoder[T]) error {
+		for runs.Len() > 0 {
+			top := runs.runs[0]
+			if err := enc.Encode(top.head); err != nil {
+				return fmt.Errorf("encoding element: %w", err)
+			}
+			ok, err := top.advance()
+			if err != nil {
+				return err
+			}
+			if ok {
+				heap.Fix(&runs, 0)
+			} else {
+				heap.Pop(&runs)
+				if err := top.close(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, name := range names {
+		os.Remove(name)
+		delete(s.files, name)
+	}
+	return name, nil
+}
+
+// spill writes sorted values to temporary file and returns name of the file.
+func (s *sorter[T]) spill(values []T) (string, error) {
+	return s.write(func(enc Encoder[T]) error {
+		for _, v := range values {
+			if err := enc.Encode(v); err != nil {
+				return fmt.Errorf("encoding element: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// write creates temporary file, writes elements to it with encode and closes the file.
+func (s *sorter[T]) write(encode func(enc Encoder[T]) error) (string, error) {
+	f, err := os.CreateTemp(s.options.tempDir, "itertools-extsort-*")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary file: %w", err)
+	}
+	if s.files == nil {
+		s.files = make(map[string]struct{})
+	}
+	s.files[f.Name()] = struct{}{}
+
+	w := bufio.NewWriter(f)
+	if err := encode(s.codec.NewEncoder(w)); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return "", fmt.Errorf("writing temporary file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("closing temporary file: %w", err)
+	}
+	return f.Name(), nil
+}
+
+// cleanup closes and removes all temporary files.
+// After cleanup the sorter yields no elements.
+func (s *sorter[T]) cleanup() {
+	s.started = true
+	for _, r := range s.runs.runs {
+		r.close()
+	}
+	s.runs.runs = nil
+	for name := range s.files {
+		os.Remove(name)
+	}
+	s.files = nil
+}
+
+// run is a sorted sequence of elements.
+type run[T any] struct {
+	idx     int
+	head    T
+	next    func() (T, bool, error)
+	closeFn func() error
+}
+
+func newMemoryRun[T any](values []T, idx int) *run[T] {
+	var pos int
+	return &run[T]{
+		idx: idx,
+		next: func() (T, bool, error) {
+			if pos >= len(values) {
+				var zero T
+				return zero, false, nil
+			}
+			v := values[pos]
+			pos++
+			return v, true, nil
+		},
+	}
+}
+
+// openRun opens temporary file with spilled run.
+func openRun[T any](name string, codec Codec[T], idx int) (*run[T], error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("opening temporary file: %w", err)
+	}
+	return newFileRun(f, codec.NewDecoder(bufio.NewReader(f)), idx), nil
+}
+
+func newFileRun[T any](f *os.File, dec Decoder[T], idx int) *run[T] {
+	return &run[T]{
+		idx: idx,
+		next: func() (T, bool, error) {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				if errors.Is(err, io.EOF) {
+					return v, false, nil
+				}
+				return v, false, fmt.Errorf("decoding element: %w", err)
+			}
+			return v, true, nil
+		},
+		closeFn: f.Close,
+	}
+}
+
+// advance reads the next element of run into head.
+func (r *run[T]) advance() (bool, error) {
+	v, ok, err := r.next()
+	if ok {
+		r.head = v
+	}
+	return ok, err
+}
+
+func (r *run[T]) close() error {
+	if r.closeFn == nil {
+		return nil
+	}
+	closeFn := r.closeFn
+	r.closeFn = nil
+	return closeFn()
+}
+
+// runHeap is a min-heap of runs ordered by their heads.
+// Runs with equal heads are ordered by their position in source iterator
+// to keep the sort stable.
+type runHeap[T any] struct {
+	runs []*run[T]
+	cmp  func(T, T) int
+}
+
+func (h *runHeap[T]) Len() int {
+	return len(h.runs)
+}
+
+func (h *runHeap[T]) Less(i, j int) bool {
+	if c := h.cmp(h.runs[i].head, h.runs[j].head); c != 0 {
+		return c < 0
+	}
+	return h.runs[i].idx < h.runs[j].idx
+}
+
+func (h *runHeap[T]) Swap(i, j int) {
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+func (h *runHeap[T]) Push(x any) {
+	h.runs = append(h.runs, x.(*run[T]))
+}
+
+func (h *runHeap[T]) Pop() any {
+	last := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+	return last
+}
@@ -0,0 +1,297 @@
+package extsort_test
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/extsort"
+	"io"
+	"math/rand"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestSorted(t *testing.T) {
+	t.Run("multiple runs", func(t *testing.T) {
+		dir := t.TempDir()
+		data := rand.New(rand.NewSource(42)).Perm(1000)
+
+		i := extsort.Sorted(
+			itertools.NewSliceIterator(data),
+			extsort.WithRunSize(64),
+			extsort.WithTempDir(dir),
+		)
+
+		var result []int
+		for i.Next() {
+			result = append(result, i.Elem())
+			if len(result) == 1 {
+				if files := dirEntries(t, dir); files != 15 {
+					t.Errorf("expected %d temporary files, got %d", 15, files)
+				}
+			}
+		}
+		if err := i.Err(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := slices.Clone(data)
+		slices.Sort(expected)
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if files := dirEntries(t, dir); files != 0 {
+			t.Errorf("expected temporary files to be removed, but %d files left", files)
+		}
+	})
+
+	t.Run("in memory", func(t *testing.T) {
+		dir := t.TempDir()
+
+		i := extsort.Sorted(
+			itertools.NewSliceIterator([]string{"b", "c", "a"}),
+			extsort.WithTempDir(dir),
+		)
+		i.Next()
+		if files := dirEntries(t, dir); files != 0 {
+			t.Errorf("did not expect temporary files, but got %d", files)
+		}
+
+		result := append([]string{i.Elem()}, i.Collect()...)
+		expected := []string{"a", "b", "c"}
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		i := extsort.Sorted(itertools.NewSliceIterator([]int{}))
+		if i.Next() {
+			t.Errorf("did not expect elements in empty iterator; elem: %d", i.Elem())
+		}
+		if err := i.Err(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		source := itertools.NewSliceIterator([]int{3, 2, 1})
+		i := extsort.Sorted(source)
+		if lower, _ := source.SizeHint(); lower != 3 {
+			t.Errorf("expected source iterator to be untouched")
+		}
+		i.Close()
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		type record struct {
+			Key   int
+			Value string
+		}
+		data := []record{
+			{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {0, "e"},
+			{2, "f"}, {1, "g"}, {0, "h"}, {2, "i"}, {1, "j"},
+		}
+
+		result := extsort.SortedBy(
+			itertools.NewSliceIterator(data),
+			func(a, b record) int { return cmp.Compare(a.Key, b.Key) },
+			extsort.WithRunSize(3),
+			extsort.WithTempDir(t.TempDir()),
+		).Collect()
+
+		expected := slices.Clone(data)
+		slices.SortStableFunc(expected, func(a, b record) int { return cmp.Compare(a.Key, b.Key) })
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("close removes files", func(t *testing.T) {
+		dir := t.TempDir()
+
+		i := extsort.Sorted(
+			itertools.NewSliceIterator([]int{5, 4, 3, 2, 1}),
+			extsort.WithRunSize(2),
+			extsort.WithTempDir(dir),
+		)
+		i.Next()
+		if files := dirEntries(t, dir); files != 2 {
+			t.Errorf("expected %d temporary files, got %d", 2, files)
+		}
+
+		i.Close()
+		if files := dirEntries(t, dir); files != 0 {
+			t.Errorf("expected temporary files to be removed, but %d files left", files)
+		}
+		if i.Next() {
+			t.Errorf("did not expect elements after Close; elem: %d", i.Elem())
+		}
+	})
+
+	t.Run("runs are spilled before reading next run", func(t *testing.T) {
+		dir := t.TempDir()
+		const runSize = 4
+		var produced int
+
+		result := extsort.Sorted(
+			itertools.New(func() (int, bool) {
+				if produced >= 3*runSize {
+					return 0, false
+				}
+				if files := dirEntries(t, dir); files != produced/runSize {
+					t.Errorf("expected %d temporary files after %d elements, got %d",
+						produced/runSize, produced, files)
+				}
+				produced++
+				return 3*runSize - produced, true
+			}),
+			extsort.WithRunSize(runSize),
+			extsort.WithTempDir(dir),
+		).Collect()
+
+		expected := itertools.Range(0, 3*runSize, 1).Collect()
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("many runs", func(t *testing.T) {
+		dir := t.TempDir()
+		type record struct {
+			Key, Pos int
+		}
+		rnd := rand.New(rand.NewSource(42))
+		data := make([]record, 1000)
+		for pos := range data {
+			data[pos] = record{Key: rnd.Intn(50), Pos: pos}
+		}
+
+		i := extsort.SortedBy(
+			itertools.NewSliceIterator(data),
+			func(a, b record) int { return cmp.Compare(a.Key, b.Key) },
+			extsort.WithRunSize(7),
+			extsort.WithMaxOpenRuns(3),
+			extsort.WithTempDir(dir),
+		)
+
+		var result []record
+		for i.Next() {
+			result = append(result, i.Elem())
+			if len(result) == 1 {
+				if files := dirEntries(t, dir); files > 3 {
+					t.Errorf("expected at most %d temporary files, got %d", 3, files)
+				}
+			}
+		}
+		if err := i.Err(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := slices.Clone(data)
+		slices.SortStableFunc(expected, func(a, b record) int { return cmp.Compare(a.Key, b.Key) })
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if files := dirEntries(t, dir); files != 0 {
+			t.Errorf("expected temporary files to be removed, but %d files left", files)
+		}
+	})
+
+	t.Run("mismatched codec", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected panic")
+			}
+		}()
+
+		extsort.Sorted(
+			itertools.NewSliceIterator([]int{3, 1, 2}),
+			extsort.WithCodec[uint32](binaryCodec{}),
+		)
+	})
+
+	t.Run("custom codec", func(t *testing.T) {
+		result := extsort.Sorted(
+			itertools.NewSliceIterator([]uint32{7, 3, 9, 1, 5}),
+			extsort.WithRunSize(2),
+			extsort.WithTempDir(t.TempDir()),
+			extsort.WithCodec[uint32](binaryCodec{}),
+		).Collect()
+
+		expected := []uint32{1, 3, 5, 7, 9}
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("codec error", func(t *testing.T) {
+		dir := t.TempDir()
+		type unencodable struct {
+			f func()
+		}
+
+		i := extsort.SortedBy(
+			itertools.NewSliceIterator([]unencodable{{}, {}, {}}),
+			func(unencodable, unencodable) int { return 0 },
+			extsort.WithRunSize(1),
+			extsort.WithTempDir(dir),
+		)
+		if i.Next() {
+			t.Errorf("did not expect elements; elem: %v", i.Elem())
+		}
+		if i.Err() == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if files := dirEntries(t, dir); files != 0 {
+			t.Errorf("expected temporary files to be removed, but %d files left", files)
+		}
+	})
+}
+
+func dirEntries(t *testing.T, dir string) int {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read directory: %v", err)
+	}
+	return len(entries)
+}
+
+type binaryCodec struct{}
+
+func (binaryCodec) NewEncoder(w io.Writer) extsort.Encoder[uint32] {
+	return binaryEncoder{w: w}
+}
+
+func (binaryCodec) NewDecoder(r io.Reader) extsort.Decoder[uint32] {
+	return binaryDecoder{r: r}
+}
+
+type binaryEncoder struct {
+	w io.Writer
+}
+
+func (e binaryEncoder) Encode(v uint32) error {
+	return binary.Write(e.w, binary.LittleEndian, v)
+}
+
+type binaryDecoder struct {
+	r io.Reader
+}
+
+func (d binaryDecoder) Decode(v *uint32) error {
+	err := binary.Read(d.r, binary.LittleEndian, v)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return io.EOF
+	}
+	return err
+}
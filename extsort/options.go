@@ -0,0 +1,58 @@
+package extsort
+
+// DefaultRunSize is the default maximum amount of elements kept in memory.
+const DefaultRunSize = 1 << 16
+
+// DefaultMaxOpenRuns is the default maximum amount of temporary files read at once.
+const DefaultMaxOpenRuns = 128
+
+type sortOptions struct {
+	runSize     int
+	maxOpenRuns int
+	tempDir     string
+	codec       any
+}
+
+// Option allows to configure external sort.
+type Option func(options *sortOptions)
+
+// WithRunSize sets maximum amount of elements kept in memory.
+// Every runSize elements are sorted and spilled to temporary file as a single run.
+// Non-positive values are ignored.
+func WithRunSize(runSize int) Option {
+	return func(o *sortOptions) {
+		if runSize > 0 {
+			o.runSize = runSize
+		}
+	}
+}
+
+// WithMaxOpenRuns sets maximum amount of temporary files read at once while merging runs,
+// which bounds the amount of file descriptors used by the sort.
+// If there are more spilled runs, they are merged in several passes into fewer larger runs,
+// so every element is read and written once more per pass.
+// Values less than 2 are ignored.
+func WithMaxOpenRuns(maxOpenRuns int) Option {
+	return func(o *sortOptions) {
+		if maxOpenRuns >= 2 {
+			o.maxOpenRuns = maxOpenRuns
+		}
+	}
+}
+
+// WithTempDir sets directory for temporary files.
+// By default, the directory returned by os.TempDir is used.
+func WithTempDir(dir string) Option {
+	return func(o *sortOptions) {
+		o.tempDir = dir
+	}
+}
+
+// WithCodec sets codec used to write and read temporary files.
+// Codec must have the same element type as the sorted iterator,
+// otherwise Sorted and SortedBy panic. By default, GobCodec is used.
+func WithCodec[T any](codec Codec[T]) Option {
+	return func(o *sortOptions) {
+		o.codec = codec
+	}
+}
@@ -0,0 +1,190 @@
+package itertools
+
+import (
+	"context"
+	"sync"
+)
+
+// FanIn creates new iterator that yields values from all given channels
+// in order of their arrival. The iteration is over when all channels are closed
+// or ctx is done. Every channel is read by its own goroutine, which is started
+// on the first access to the elements of returned iterator and is stopped on Close.
+func FanIn[T any](ctx context.Context, chans ...<-chan T) *Iterator[T] {
+	var (
+		zero   T
+		merged chan T
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+	)
+	start := func() {
+		var fanInCtx context.Context
+		fanInCtx, cancel = context.WithCancel(ctx)
+		merged = make(chan T)
+		wg.Add(len(chans))
+		for _, ch := range chans {
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case v, ok := <-ch:
+						if !ok {
+							return
+						}
+						select {
+						case merged <- v:
+						case <-fanInCtx.Done():
+							return
+						}
+					case <-fanInCtx.Done():
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(merged)
+		}()
+	}
+	return New(func() (T, bool) {
+		if merged == nil {
+			start()
+		}
+		if ctx.Err() != nil {
+			return zero, false
+		}
+		v, ok := <-merged
+		return v, ok
+	}).onClose(func() {
+		if merged != nil {
+			cancel()
+			wg.Wait()
+		}
+	})
+}
+
+// Broadcast sends every element of iterator to each of n returned channels.
+// Channels are unbuffered, so the next element is read from iterator
+// only after all channels received the current one (i.e. the slowest consumer
+// defines the pace), and channels must be read concurrently. Elements are sent by background goroutine, which closes
+// the channels and the iterator when the iterator is exhausted.
+// Consumers must receive all elements from all channels, otherwise the goroutine is blocked;
+// use BroadcastContext to stop it.
+// If n is not positive, Broadcast returns nil.
+func Broadcast[T any](i *Iterator[T], n int) []<-chan T {
+	return BroadcastContext(context.Background(), i, n)
+}
+
+// BroadcastContext works like Broadcast (see Broadcast), but also stops
+// sending elements when ctx is done.
+func BroadcastContext[T any](ctx context.Context, i *Iterator[T], n int) []<-chan T {
+	if n <= 0 {
+		return nil
+	}
+	chans := makeChans[T](n)
+	go func() {
+		defer closeChans(chans)
+		defer i.Close()
+		for i.Next() {
+			v := i.Elem()
+			for _, ch := range chans {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return receiveOnly(chans)
+}
+
+// DistributeStrategy defines to which of n consumers the element is sent by Distribute.
+// DistributeStrategy returns index of consumer from 0 to n-1.
+type DistributeStrategy[T any] func(elem T, n int) int
+
+// RoundRobinStrategy returns DistributeStrategy that sends elements
+// to consumers in turn.
+func RoundRobinStrategy[T any]() DistributeStrategy[T] {
+	var idx int
+	return func(_ T, n int) int {
+		current := idx % n
+		idx = current + 1
+		return current
+	}
+}
+
+// HashStrategy returns DistributeStrategy that sends elements
+// to consumers by hash of element returned by function hash,
+// so that elements with equal hashes are sent to the same consumer.
+func HashStrategy[T any](hash func(T) uint64) DistributeStrategy[T] {
+	return func(elem T, n int) int {
+		return int(hash(elem) % uint64(n))
+	}
+}
+
+// Distribute sends every element of iterator to one of n returned channels
+// chosen by strategy (e.g. RoundRobinStrategy or HashStrategy).
+// Channels are unbuffered, so the next element is read from iterator
+// only after the current one is received, and channels must be read concurrently.
+// Elements are sent by background goroutine,
+// which closes the channels and the iterator when the iterator is exhausted.
+// Consumers must receive all elements from all channels, otherwise the goroutine is blocked;
+// use DistributeContext to stop it.
+// If n is not positive or strategy is nil, Distribute returns nil.
+func Distribute[T any](i *Iterator[T], n int, strategy DistributeStrategy[T]) []<-chan T {
+	return DistributeContext(context.Background(), i, n, strategy)
+}
+
+// DistributeContext works like Distribute (see Distribute), but also stops
+// sending elements when ctx is done.
+func DistributeContext[T any](
+	ctx context.Context,
+	i *Iterator[T],
+	n int,
+	strategy DistributeStrategy[T],
+) []<-chan T {
+	if n <= 0 || strategy == nil {
+		return nil
+	}
+	chans := makeChans[T](n)
+	go func() {
+		defer closeChans(chans)
+		defer i.Close()
+		for i.Next() {
+			v := i.Elem()
+			idx := strategy(v, n) % n
+			if idx < 0 {
+				idx += n
+			}
+			select {
+			case chans[idx] <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return receiveOnly(chans)
+}
+
+func makeChans[T any](n int) []chan T {
+	chans := make([]chan T, n)
+	for idx := range chans {
+		chans[idx] = make(chan T)
+	}
+	return chans
+}
+
+func closeChans[T any](chans []chan T) {
+	for _, ch := range chans {
+		close(ch)
+	}
+}
+
+func receiveOnly[T any](chans []chan T) []<-chan T {
+	result := make([]<-chan T, len(chans))
+	for idx, ch := range chans {
+		result[idx] = ch
+	}
+	return result
+}
@@ -0,0 +1,16 @@
+//go:build go1.24
+
+package itertools
+
+import "hash/maphash"
+
+// KeyHashStrategy returns DistributeStrategy that sends elements
+// to consumers by hash of key returned by function key,
+// so that elements with equal keys are sent to the same consumer.
+// Keys are hashed with hash/maphash, so KeyHashStrategy requires Go 1.24 or newer.
+func KeyHashStrategy[T any, K comparable](key func(T) K) DistributeStrategy[T] {
+	seed := maphash.MakeSeed()
+	return HashStrategy(func(elem T) uint64 {
+		return maphash.Comparable(seed, key(elem))
+	})
+}
@@ -0,0 +1,33 @@
+//go:build go1.24
+
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"testing"
+)
+
+func TestKeyHashStrategy(t *testing.T) {
+	chans := itertools.Distribute(
+		itertools.Range(0, 100, 1),
+		4,
+		itertools.KeyHashStrategy(func(n int) int { return n % 5 }),
+	)
+
+	results := collectConcurrently(chans)
+
+	var total int
+	owner := make(map[int]int)
+	for idx, result := range results {
+		total += len(result)
+		for _, v := range result {
+			if prev, ok := owner[v%5]; ok && prev != idx {
+				t.Errorf("elements with key %d are sent to channels %d and %d", v%5, prev, idx)
+			}
+			owner[v%5] = idx
+		}
+	}
+	if total != 100 {
+		t.Errorf("expected 100 elements, got %d", total)
+	}
+}
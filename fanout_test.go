@@ -0,0 +1,149 @@
+package itertools_test
+
+import (
+	"context"
+	"github.com/KSpaceer/itertools"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestFanIn(t *testing.T) {
+	t.Run("all values", func(t *testing.T) {
+		chans := make([]<-chan int, 3)
+		for idx := range chans {
+			ch := make(chan int)
+			chans[idx] = ch
+			go func() {
+				defer close(ch)
+				for k := 0; k < 10; k++ {
+					ch <- idx*10 + k
+				}
+			}()
+		}
+
+		result := itertools.FanIn(context.Background(), chans...).Collect()
+		slices.Sort(result)
+
+		expected := itertools.Range(0, 30, 1).Collect()
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("no channels", func(t *testing.T) {
+		if count := itertools.FanIn[int](context.Background()).Count(); count != 0 {
+			t.Errorf("expected no elements, got %d", count)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		ch := make(chan int)
+		go func() {
+			for {
+				ch <- 1
+			}
+		}()
+
+		iter := itertools.FanIn(context.Background(), ch)
+		if !iter.Next() {
+			t.Fatalf("expected element")
+		}
+		iter.Close()
+
+		if iter.Next() {
+			t.Errorf("expected no elements after Close")
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if itertools.FanIn(ctx, make(chan int)).Next() {
+			t.Errorf("expected no elements after cancel")
+		}
+	})
+}
+
+func TestBroadcast(t *testing.T) {
+	chans := itertools.Broadcast(itertools.Range(0, 20, 1), 3)
+	if len(chans) != 3 {
+		t.Fatalf("expected 3 channels, got %d", len(chans))
+	}
+
+	results := collectConcurrently(chans)
+
+	expected := itertools.Range(0, 20, 1).Collect()
+	for idx, result := range results {
+		if !sliceEqual(expected, result) {
+			t.Errorf("channel %d: expected %v, got %v", idx, expected, result)
+		}
+	}
+
+	if chans := itertools.Broadcast(itertools.Range(0, 20, 1), 0); chans != nil {
+		t.Errorf("expected nil for zero channels")
+	}
+}
+
+func TestDistribute(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		chans := itertools.Distribute(itertools.Range(0, 9, 1), 3, itertools.RoundRobinStrategy[int]())
+
+		results := collectConcurrently(chans)
+
+		expected := [][]int{{0, 3, 6}, {1, 4, 7}, {2, 5, 8}}
+		if !nestedSliceEqual(expected, results) {
+			t.Errorf("expected %v, got %v", expected, results)
+		}
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		chans := itertools.Distribute(
+			itertools.Range(0, 100, 1),
+			4,
+			itertools.HashStrategy(func(n int) uint64 { return uint64(n % 5) }),
+		)
+
+		results := collectConcurrently(chans)
+
+		var total int
+		owner := make(map[int]int)
+		for idx, result := range results {
+			total += len(result)
+			for _, v := range result {
+				if prev, ok := owner[v%5]; ok && prev != idx {
+					t.Errorf("elements with key %d are sent to channels %d and %d", v%5, prev, idx)
+				}
+				owner[v%5] = idx
+			}
+		}
+		if total != 100 {
+			t.Errorf("expected 100 elements, got %d", total)
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		chans := itertools.DistributeContext(ctx, itertools.Repeat(1), 2, itertools.RoundRobinStrategy[int]())
+
+		<-chans[0]
+		cancel()
+
+		collectConcurrently(chans)
+	})
+}
+
+func collectConcurrently[T any](chans []<-chan T) [][]T {
+	results := make([][]T, len(chans))
+	var wg sync.WaitGroup
+	for idx, ch := range chans {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[idx] = itertools.NewChanIterator(ch).Collect()
+		}()
+	}
+	wg.Wait()
+	return results
+}
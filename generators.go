@@ -0,0 +1,168 @@
+package itertools
+
+import (
+	"golang.org/x/exp/constraints"
+	"iter"
+)
+
+// Number is a constraint for integer and floating-point types.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Range creates new iterator that yields numbers from start (inclusively)
+// to stop (exclusively) with given step. Step can be negative,
+// in which case numbers are yielded in descending order.
+// For floating-point types every yielded number is computed as start + k*step
+// to avoid accumulation of rounding errors.
+// For integer types iteration stops before the yielded number overflows.
+// If step is zero, Range returns empty iterator.
+func Range[T Number](start, stop, step T) *Iterator[T] {
+	var zero T
+	if step == 0 {
+		return empty[T]()
+	}
+	var (
+		isInteger = T(1)/T(2) == 0
+		k         int
+		current   = start
+		stopped   bool
+	)
+	return New(func() (T, bool) {
+		if stopped || (step > 0 && current >= stop) || (step < 0 && current <= stop) {
+			stopped = true
+			return zero, false
+		}
+		v := current
+		k++
+		if isInteger {
+			next := current + step
+			if (step > 0) != (next > current) {
+				stopped = true
+			}
+			current = next
+		} else {
+			current = start + T(k)*step
+		}
+		return v, true
+	})
+}
+
+// CountFrom creates new iterator that endlessly yields numbers
+// start, start+1, start+2 etc.
+func CountFrom[T Number](start T) *Iterator[T] {
+	var k T
+	return New(func() (T, bool) {
+		v := start + k
+		k++
+		return v, true
+	})
+}
+
+// Linspace creates new iterator that yields num evenly spaced numbers
+// from start to stop (both inclusively).
+// If num is non-positive, Linspace returns empty iterator.
+// If num is 1, Linspace yields only start.
+func Linspace[T constraints.Float](start, stop T, num int) *Iterator[T] {
+	var zero T
+	var k int
+	return New(func() (T, bool) {
+		if k >= num {
+			return zero, false
+		}
+		var v T
+		switch k {
+		case 0:
+			v = start
+		case num - 1:
+			v = stop
+		default:
+			v = start + (stop-start)*T(k)/T(num-1)
+		}
+		k++
+		return v, true
+	})
+}
+
+// Iterate creates new iterator that endlessly yields seed, f(seed), f(f(seed)) etc.
+func Iterate[T any](seed T, f func(T) T) *Iterator[T] {
+	var started bool
+	return New(func() (T, bool) {
+		if started {
+			seed = f(seed)
+		}
+		started = true
+		return seed, true
+	})
+}
+
+// Successors creates new iterator that yields first and then every
+// next element computed by succ from the previous one, until succ returns false.
+func Successors[T any](first T, succ func(T) (T, bool)) *Iterator[T] {
+	var (
+		started bool
+		stopped bool
+		zero    T
+	)
+	return New(func() (T, bool) {
+		if stopped {
+			return zero, false
+		}
+		if started {
+			next, ok := succ(first)
+			if !ok {
+				stopped = true
+				return zero, false
+			}
+			first = next
+		}
+		started = true
+		return first, true
+	})
+}
+
+// Unfold creates new iterator that yields elements produced by f from the state.
+// Function f returns element, the next state and boolean value indicating
+// if the element is valid (i.e. false means that the iteration is over).
+func Unfold[T, S any](state S, f func(S) (T, S, bool)) *Iterator[T] {
+	var (
+		stopped bool
+		zero    T
+	)
+	return New(func() (T, bool) {
+		if stopped {
+			return zero, false
+		}
+		v, next, ok := f(state)
+		if !ok {
+			stopped = true
+			return zero, false
+		}
+		state = next
+		return v, true
+	})
+}
+
+// RepeatN creates new iterator that yields elem n times.
+func RepeatN[T any](elem T, n int) *Iterator[T] {
+	return Repeat(elem).Limit(n)
+}
+
+// RepeatWith creates new iterator that endlessly yields elements produced by f.
+func RepeatWith[T any](f func() T) *Iterator[T] {
+	return New(func() (T, bool) {
+		return f(), true
+	})
+}
+
+// Generate creates new iterator from push-style producer function.
+// The producer passes elements to yield function and must return
+// as soon as yield returns false.
+// Generate uses iter.Pull to convert the producer into pull-based iterator,
+// therefore the producer is run only when elements are requested.
+// If the iterator is not exhausted, it must be closed with Close
+// to unwind the producer and release its resources.
+func Generate[T any](producer func(yield func(T) bool)) *Iterator[T] {
+	next, stop := iter.Pull(iter.Seq[T](producer))
+	return NewWithClose(next, stop)
+}
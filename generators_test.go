@@ -0,0 +1,215 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math"
+	"testing"
+)
+
+func TestGenerators(t *testing.T) {
+	t.Run("range", func(t *testing.T) {
+		result := itertools.Range(1, 10, 3).Collect()
+
+		expected := []int{1, 4, 7}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("descending range", func(t *testing.T) {
+		result := itertools.Range(5, 0, -2).Collect()
+
+		expected := []int{5, 3, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zero step range", func(t *testing.T) {
+		i := itertools.Range(0, 10, 0)
+		if i.Next() {
+			t.Errorf("did not expect elements in zero step range; elem: %d", i.Elem())
+		}
+	})
+
+	t.Run("overflowing range", func(t *testing.T) {
+		result := itertools.Range[int8](0, 127, 100).Collect()
+
+		expected := []int8{0, 100}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("float range", func(t *testing.T) {
+		result := itertools.Range(0, 1, 0.1).Collect()
+
+		if len(result) != 10 {
+			t.Fatalf("expected %d elements, got %v", 10, result)
+		}
+		for k, v := range result {
+			if expected := float64(k) * 0.1; v != expected {
+				t.Errorf("expected %v at position %d, got %v", expected, k, v)
+			}
+		}
+	})
+
+	t.Run("count from", func(t *testing.T) {
+		result := itertools.CountFrom[uint](5).Limit(3).Collect()
+
+		expected := []uint{5, 6, 7}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("linspace", func(t *testing.T) {
+		result := itertools.Linspace(0.0, 1.0, 5).Collect()
+
+		expected := []float64{0, 0.25, 0.5, 0.75, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("single element linspace", func(t *testing.T) {
+		result := itertools.Linspace(2.0, 3.0, 1).Collect()
+
+		expected := []float64{2}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("iterate", func(t *testing.T) {
+		result := itertools.Iterate(1, func(n int) int { return n * 2 }).Limit(5).Collect()
+
+		expected := []int{1, 2, 4, 8, 16}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("successors", func(t *testing.T) {
+		result := itertools.Successors(1000, func(n int) (int, bool) {
+			return n / 10, n >= 10
+		}).Collect()
+
+		expected := []int{1000, 100, 10, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("unfold", func(t *testing.T) {
+		type state struct{ a, b int }
+		result := itertools.Unfold(state{0, 1}, func(s state) (int, state, bool) {
+			return s.a, state{s.b, s.a + s.b}, s.a <= 100
+		}).Collect()
+
+		expected := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("repeat n", func(t *testing.T) {
+		result := itertools.RepeatN("a", 3).Collect()
+
+		expected := []string{"a", "a", "a"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("repeat with", func(t *testing.T) {
+		var n float64
+		result := itertools.RepeatWith(func() float64 {
+			n++
+			return math.Sqrt(n)
+		}).Limit(3).Collect()
+
+		expected := []float64{1, math.Sqrt(2), math.Sqrt(3)}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	type tree struct {
+		left, right *tree
+		value       int
+	}
+	root := &tree{
+		value: 4,
+		left: &tree{
+			value: 2,
+			left:  &tree{value: 1},
+			right: &tree{value: 3},
+		},
+		right: &tree{
+			value: 6,
+			left:  &tree{value: 5},
+		},
+	}
+	var walk func(t *tree, yield func(int) bool) bool
+	walk = func(t *tree, yield func(int) bool) bool {
+		if t == nil {
+			return true
+		}
+		return walk(t.left, yield) && yield(t.value) && walk(t.right, yield)
+	}
+
+	t.Run("tree walk", func(t *testing.T) {
+		i := itertools.Generate(func(yield func(int) bool) {
+			walk(root, yield)
+		})
+		result := i.Collect()
+
+		expected := []int{1, 2, 3, 4, 5, 6}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("close unwinds producer", func(t *testing.T) {
+		var unwound bool
+		i := itertools.Generate(func(yield func(int) bool) {
+			defer func() { unwound = true }()
+			walk(root, yield)
+		})
+		limited := itertools.Map(i.Limit(2), func(n int) int { return n * 10 })
+		result := limited.Collect()
+
+		expected := []int{10, 20}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if unwound {
+			t.Errorf("did not expect producer to be unwound before Close")
+		}
+
+		limited.Close()
+		if !unwound {
+			t.Errorf("expected producer to be unwound after Close")
+		}
+		if i.Next() {
+			t.Errorf("did not expect elements after Close; elem: %d", i.Elem())
+		}
+		limited.Close()
+	})
+}
@@ -1,5 +1,5 @@
 module github.com/KSpaceer/itertools
 
-go 1.22.0
+go 1.23.0
 
 require golang.org/x/exp v0.0.0-20231226003508-02704c960a9b
@@ -1,6 +1,9 @@
 package itertools
 
-import "slices"
+import (
+	"math"
+	"slices"
+)
 
 // Iterator is used to process all elements of some collection
 // or sequence. Iterator contains methods to access the elements
@@ -9,6 +12,10 @@ type Iterator[T any] struct {
 	f          func() (T, bool)
 	value      T
 	canProceed bool
+	closers    []func()
+	hint       func() (lower, upper int)
+	skip       func(n int) int
+	back       func() (T, bool)
 }
 
 // New creates new Iterator using given iteration function.
@@ -21,6 +28,14 @@ func New[T any](f func() (T, bool)) *Iterator[T] {
 	}
 }
 
+// NewWithClose creates new Iterator using given iteration function
+// and function closeFunc releasing resources of the iterator.
+// Function closeFunc is called on Close of the iterator or any iterator
+// derived from it.
+func NewWithClose[T any](f func() (T, bool), closeFunc func()) *Iterator[T] {
+	return New(f).onClose(closeFunc)
+}
+
 // Next proceeds iterator to the next element, returning boolean value
 // to show that said element exists.
 func (i *Iterator[T]) Next() bool {
@@ -37,9 +52,63 @@ func (i *Iterator[T]) Elem() T {
 	return i.value
 }
 
+// Close stops the iteration and releases resources held by the iterator
+// and its source iterators (e.g. stops the producer of Generate iterator).
+// After Close the iterator yields no elements.
+// Close can be called multiple times.
+func (i *Iterator[T]) Close() {
+	i.canProceed = false
+	for _, closeFunc := range i.closers {
+		closeFunc()
+	}
+}
+
+// onClose adds functions to call on Close of the iterator.
+func (i *Iterator[T]) onClose(closeFuncs ...func()) *Iterator[T] {
+	i.closers = append(i.closers, closeFuncs...)
+	return i
+}
+
+// SizeHint returns bounds on the amount of remaining elements in iterator.
+// The lower bound is always valid, while the upper bound is negative
+// if it is unknown (e.g. the iterator is infinite).
+// Iterators created with New have no information about their size,
+// so SizeHint returns (0, -1) for them. Iterators for slices, strings and maps
+// and iterators derived from them (e.g. by Map, Zip or Limit) provide actual bounds.
+func (i *Iterator[T]) SizeHint() (lower, upper int) {
+	if !i.canProceed {
+		return 0, 0
+	}
+	return i.sizeHint()
+}
+
+func (i *Iterator[T]) sizeHint() (lower, upper int) {
+	if i.hint == nil {
+		return 0, -1
+	}
+	return i.hint()
+}
+
+// withHint sets function returning bounds on the amount of remaining elements.
+func (i *Iterator[T]) withHint(hint func() (lower, upper int)) *Iterator[T] {
+	i.hint = hint
+	return i
+}
+
+// withSkip sets function skipping up to n elements without yielding them.
+// The function returns amount of skipped elements.
+func (i *Iterator[T]) withSkip(skip func(n int) int) *Iterator[T] {
+	i.skip = skip
+	return i
+}
+
 // Count returns amount of remaining elements in iterator.
 // Call of Count consumes all elements.
+// For iterators of slices and ASCII strings Count takes O(1) time.
 func (i *Iterator[T]) Count() int {
+	if i.skip != nil {
+		return i.Drop(math.MaxInt)
+	}
 	var count int
 	for i.Next() {
 		count++
@@ -50,13 +119,32 @@ func (i *Iterator[T]) Count() int {
 // Drop skips next n elements in iterator, returning
 // amount of skipped elements (if iterator has fewer elements than n, returned value
 // is equal to the amount of elements).
+// For iterators of slices and ASCII strings Drop takes O(1) time.
 func (i *Iterator[T]) Drop(n int) int {
+	if i.skip != nil {
+		if !i.canProceed || n <= 0 {
+			return 0
+		}
+		return i.skip(n)
+	}
 	var droppedCount int
 	for ; droppedCount < n && i.Next(); droppedCount++ {
 	}
 	return droppedCount
 }
 
+// Nth returns n-th (starting from 0) of remaining elements of iterator,
+// consuming all elements before it and the element itself.
+// The returned boolean value shows if the element exists.
+// For iterators of slices and ASCII strings Nth takes O(1) time.
+func (i *Iterator[T]) Nth(n int) (T, bool) {
+	var zero T
+	if n < 0 || i.Drop(n) < n || !i.Next() {
+		return zero, false
+	}
+	return i.Elem(), true
+}
+
 // Limit produces new iterator that can return at most size elements.
 func (i *Iterator[T]) Limit(size int) *Iterator[T] {
 	var zero T
@@ -74,14 +162,31 @@ func (i *Iterator[T]) Limit(size int) *Iterator[T] {
 		v := i.Elem()
 		count++
 		return v, true
+	}).onClose(i.Close).withHint(func() (int, int) {
+		lower, upper := i.sizeHint()
+		remaining := size - count
+		if upper < 0 || upper > remaining {
+			upper = remaining
+		}
+		return min(lower, remaining), upper
 	})
 }
 
 // WithStep produces new iterator that yields every "step"th element of underlying iterator
-// If step is non-positive, returns empty iterator.
+// If step is negative, the produced iterator yields every "-step"th element
+// of reversed underlying iterator (see Rev).
+// If step is zero, returns empty iterator.
 func (i *Iterator[T]) WithStep(step int) *Iterator[T] {
 	var zero T
-	if step <= 0 {
+	if step == math.MinInt {
+		// -math.MinInt overflows, but any step exceeding the amount of elements
+		// yields only the first element of reversed iterator
+		return i.Rev().WithStep(math.MaxInt)
+	}
+	if step < 0 {
+		return i.Rev().WithStep(-step)
+	}
+	if step == 0 {
 		return New(func() (T, bool) {
 			return zero, false
 		})
@@ -99,7 +204,7 @@ func (i *Iterator[T]) WithStep(step int) *Iterator[T] {
 				return v, ok
 			}
 		}
-	})
+	}).onClose(i.Close)
 }
 
 // Range calls function f for every element of iterator until the function
@@ -116,7 +221,7 @@ func (i *Iterator[T]) Range(f func(T) bool) {
 // for which function f returns true.
 func (i *Iterator[T]) Filter(f func(T) bool) *Iterator[T] {
 	var zero T
-	return New(func() (T, bool) {
+	filtered := New(func() (T, bool) {
 		for {
 			v, ok := i.f()
 			if !ok {
@@ -126,7 +231,24 @@ func (i *Iterator[T]) Filter(f func(T) bool) *Iterator[T] {
 				return v, true
 			}
 		}
+	}).onClose(i.Close).withHint(func() (int, int) {
+		_, upper := i.sizeHint()
+		return 0, upper
 	})
+	if i.back != nil {
+		filtered.withBack(func() (T, bool) {
+			for {
+				v, ok := i.back()
+				if !ok {
+					return zero, false
+				}
+				if f(v) {
+					return v, true
+				}
+			}
+		})
+	}
+	return filtered
 }
 
 // Collect returns all elements of iterator as slice.
@@ -135,7 +257,7 @@ func (i *Iterator[T]) Collect(opts ...AllocationOption) []T {
 	for _, opt := range opts {
 		opt(&options)
 	}
-	elems := make([]T, 0, options.preallocSize)
+	elems := make([]T, 0, i.preallocSize(options))
 	for i.Next() {
 		elems = append(elems, i.Elem())
 	}
@@ -188,19 +310,10 @@ func (i *Iterator[T]) Any(f func(T) bool) bool {
 //   - -1: if the first argument is less than second one
 //   - 0: if two arguments are equal
 //   - 1: if the first argument is greater than second one
+//
+// If iterator is empty, Max returns zero value. Use MaxOpt to distinguish this case.
 func (i *Iterator[T]) Max(f func(T, T) int) T {
-	if !i.Next() {
-		var zero T
-		return zero
-	}
-	maxValue := i.Elem()
-	for i.Next() {
-		v := i.Elem()
-		if f(maxValue, v) < 0 {
-			maxValue = v
-		}
-	}
-	return maxValue
+	return i.MaxOpt(f).value
 }
 
 // SortedBy returns new iterator yielding elements of source iterator in sorted order.
@@ -209,8 +322,44 @@ func (i *Iterator[T]) Max(f func(T, T) int) T {
 //   - -1: if the first argument is less than second one
 //   - 0: if two arguments are equal
 //   - 1: if the first argument is greater than second one
+//
+// SortedBy is lazy: elements of source iterator are collected and sorted
+// on the first access to the elements of returned iterator.
 func (i *Iterator[T]) SortedBy(cmp func(T, T) int, opts ...AllocationOption) *Iterator[T] {
-	values := i.Collect(opts...)
-	slices.SortFunc(values, cmp)
-	return NewSliceIterator(values)
+	return sortedLazily(i, func(values []T) {
+		slices.SortFunc(values, cmp)
+	}, opts)
+}
+
+// SortedStableBy works like SortedBy, but keeps the original order of equal elements.
+func (i *Iterator[T]) SortedStableBy(cmp func(T, T) int, opts ...AllocationOption) *Iterator[T] {
+	return sortedLazily(i, func(values []T) {
+		slices.SortStableFunc(values, cmp)
+	}, opts)
+}
+
+// sortedLazily creates iterator that collects elements of source iterator
+// and sorts them with sortFunc on the first access to its elements.
+func sortedLazily[T any](i *Iterator[T], sortFunc func([]T), opts []AllocationOption) *Iterator[T] {
+	var sorted *Iterator[T]
+	init := func() *Iterator[T] {
+		if sorted == nil {
+			values := i.Collect(opts...)
+			sortFunc(values)
+			sorted = NewSliceIterator(values)
+		}
+		return sorted
+	}
+	return New(func() (T, bool) {
+		return init().f()
+	}).withBack(func() (T, bool) {
+		return init().back()
+	}).withSkip(func(n int) int {
+		return init().skip(n)
+	}).withHint(func() (int, int) {
+		if sorted == nil {
+			return i.sizeHint()
+		}
+		return sorted.sizeHint()
+	}).onClose(i.Close)
 }
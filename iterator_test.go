@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"fmt"
 	"github.com/KSpaceer/itertools"
+	"math"
 	"slices"
 	"strings"
 	"testing"
@@ -161,6 +162,16 @@ func TestFibonacciIterator(t *testing.T) {
 			{
 				name: "negative step",
 				step: -1,
+				expected: func() []int {
+					result := slices.Clone(collectedValues)
+					slices.Reverse(result)
+					return result
+				}(),
+			},
+			{
+				name:     "min int step",
+				step:     math.MinInt,
+				expected: []int{collectedValues[len(collectedValues)-1]},
 			},
 			{
 				name:     "giant step",
@@ -3,6 +3,7 @@ package itertools
 import (
 	"cmp"
 	"golang.org/x/exp/constraints"
+	"math"
 	"slices"
 )
 
@@ -23,13 +24,118 @@ func Chain[T any](iters ...*Iterator[T]) *Iterator[T] {
 			i++
 		}
 		return zero, false
+	}).onClose(closeAll(iters)).withHint(func() (int, int) {
+		var lower, upper int
+		for _, iter := range iters[i:] {
+			iterLower, iterUpper := iter.sizeHint()
+			lower, upper = sumHints(lower, upper, iterLower, iterUpper)
+		}
+		return lower, upper
+	})
+}
+
+// Interleave combines iterators, returning iterator that yields
+// one element from each iterator in turn: the first element of the first iterator,
+// the first element of the second iterator etc.
+// Exhausted iterators are skipped, so the result iterator yields
+// elements until all source iterators are empty.
+func Interleave[T any](iters ...*Iterator[T]) *Iterator[T] {
+	return RoundRobin(nil, iters...)
+}
+
+// RoundRobin combines iterators, returning iterator that yields
+// weights[k] elements from k-th iterator in turn.
+// If weights has fewer values than there are iterators, missing weights are equal to 1.
+// Iterators with non-positive weight are skipped.
+// Exhausted iterators are skipped, so the result iterator yields
+// elements until all source iterators are empty.
+func RoundRobin[T any](weights []int, iters ...*Iterator[T]) *Iterator[T] {
+	type source struct {
+		iter   *Iterator[T]
+		weight int
+	}
+	sources := make([]source, 0, len(iters))
+	for k, iter := range iters {
+		weight := 1
+		if k < len(weights) {
+			weight = weights[k]
+		}
+		if weight > 0 {
+			sources = append(sources, source{iter: iter, weight: weight})
+		}
+	}
+
+	var (
+		idx   int
+		taken int
+		zero  T
+	)
+	return New(func() (T, bool) {
+		for len(sources) > 0 {
+			if taken >= sources[idx].weight {
+				taken = 0
+				idx = (idx + 1) % len(sources)
+			}
+			v, ok := sources[idx].iter.f()
+			if ok {
+				taken++
+				return v, true
+			}
+			sources = slices.Delete(sources, idx, idx+1)
+			taken = 0
+			if idx >= len(sources) {
+				idx = 0
+			}
+		}
+		return zero, false
+	}).onClose(closeAll(iters)).withHint(func() (int, int) {
+		var lower, upper int
+		for _, source := range sources {
+			iterLower, iterUpper := source.iter.sizeHint()
+			lower, upper = sumHints(lower, upper, iterLower, iterUpper)
+		}
+		return lower, upper
 	})
 }
 
+// Intersperse creates new iterator that yields elements of source iterator
+// with sep placed between each pair of adjacent elements.
+func Intersperse[T any](i *Iterator[T], sep T) *Iterator[T] {
+	return IntersperseWith(i, func() T { return sep })
+}
+
+// IntersperseWith creates new iterator that yields elements of source iterator
+// with separator produced by sep placed between each pair of adjacent elements.
+// Function sep is called only when the separator is going to be yielded.
+func IntersperseWith[T any](i *Iterator[T], sep func() T) *Iterator[T] {
+	var (
+		started    bool
+		pending    T
+		hasPending bool
+		zero       T
+	)
+	return New(func() (T, bool) {
+		if hasPending {
+			hasPending = false
+			return pending, true
+		}
+		v, ok := i.f()
+		if !ok {
+			return zero, false
+		}
+		if !started {
+			started = true
+			return v, true
+		}
+		pending, hasPending = v, true
+		return sep(), true
+	}).onClose(i.Close)
+}
+
 // Zip joins two iterators into a one yielding Pair of the iterators' elements.
 // Returned iterator yields Pairs until one of source iterators is empty.
 func Zip[T, U any](t *Iterator[T], u *Iterator[U]) *Iterator[Pair[T, U]] {
-	return New(func() (Pair[T, U], bool) {
+	zipped := New(func() (Pair[T, U], bool) {
 		tElem, ok := t.f()
 		if !ok {
 			return Pair[T, U]{}, false
@@ -42,28 +148,69 @@ func Zip[T, U any](t *Iterator[T], u *Iterator[U]) *Iterator[Pair[T, U]] {
 			First:  tElem,
 			Second: uElem,
 		}, true
+	}).onClose(t.Close, u.Close).withHint(func() (int, int) {
+		tLower, tUpper := t.sizeHint()
+		uLower, uUpper := u.sizeHint()
+		return minHints(tLower, tUpper, uLower, uUpper)
 	})
+	if t.back != nil && u.back != nil && hasExactSize(t) && hasExactSize(u) {
+		zipped.withBack(func() (Pair[T, U], bool) {
+			tLen, _ := t.sizeHint()
+			uLen, _ := u.sizeHint()
+			for ; tLen > uLen; tLen-- {
+				t.back()
+			}
+			for ; uLen > tLen; uLen-- {
+				u.back()
+			}
+			tElem, ok := t.back()
+			if !ok {
+				return Pair[T, U]{}, false
+			}
+			uElem, ok := u.back()
+			if !ok {
+				return Pair[T, U]{}, false
+			}
+			return Pair[T, U]{
+				First:  tElem,
+				Second: uElem,
+			}, true
+		})
+	}
+	return zipped
 }
 
 // Map returns new iterator that yields elements of type U
 // by calling mapper to each element of type T of source iterator.
 func Map[T, U any](i *Iterator[T], mapper func(T) U) *Iterator[U] {
 	var zero U
-	return New(func() (U, bool) {
+	mapped := New(func() (U, bool) {
 		v, ok := i.f()
 		if !ok {
 			return zero, false
 		}
 		return mapper(v), true
-	})
+	}).onClose(i.Close).withHint(i.sizeHint)
+	if i.back != nil {
+		mapped.withBack(func() (U, bool) {
+			v, ok := i.back()
+			if !ok {
+				return zero, false
+			}
+			return mapper(v), true
+		})
+	}
+	return mapped
 }
 
 // Max return max value of iterator.
+// If iterator is empty, Max returns zero value (see MaxOpt).
 func Max[T cmp.Ordered](i *Iterator[T]) T {
 	return i.Max(cmp.Compare[T])
 }
 
 // Min return min value of iterator.
+// If iterator is empty, Min returns zero value (see MinOpt).
 func Min[T cmp.Ordered](i *Iterator[T]) T {
 	return i.Max(func(a T, b T) int {
 		return -cmp.Compare(a, b)
@@ -85,23 +232,22 @@ func Sum[T Summable](i *Iterator[T]) T {
 // Find applies function f to elements of iterator, returning
 // first element for which the function returned true.
 // The returned boolean value shows if the element was found (i.e. is valid).
-// If no element was found, Find returns false as second returned value.
+// If no element was found, Find returns zero value and false.
 func Find[T any](i *Iterator[T], f func(T) bool) (T, bool) {
-	var found T
 	for i.Next() {
-		found = i.Elem()
-		if f(found) {
-			return found, true
+		if v := i.Elem(); f(v) {
+			return v, true
 		}
 	}
-	return found, false
+	var zero T
+	return zero, false
 }
 
 // Enumerate creates new iterator that returns Enumeration contating
 // current element of source iterator along with current iteration count (starting from 0).
 func Enumerate[T any](i *Iterator[T]) *Iterator[Enumeration[T]] {
 	var idx int
-	return New(func() (Enumeration[T], bool) {
+	enumerated := New(func() (Enumeration[T], bool) {
 		v, ok := i.f()
 		if !ok {
 			return Enumeration[T]{}, false
@@ -112,7 +258,21 @@ func Enumerate[T any](i *Iterator[T]) *Iterator[Enumeration[T]] {
 		}
 		idx++
 		return result, true
-	})
+	}).onClose(i.Close).withHint(i.sizeHint)
+	if i.back != nil && hasExactSize(i) {
+		enumerated.withBack(func() (Enumeration[T], bool) {
+			remaining, _ := i.sizeHint()
+			v, ok := i.back()
+			if !ok {
+				return Enumeration[T]{}, false
+			}
+			return Enumeration[T]{
+				First:  v,
+				Second: idx + remaining - 1,
+			}, true
+		})
+	}
+	return enumerated
 }
 
 // Batched creates new iterator that returns slices of T (aka batch)
@@ -141,6 +301,16 @@ func Batched[T any](i *Iterator[T], batchSize int) *Iterator[[]T] {
 			result = append(result, v)
 		}
 		return result, true
+	}).onClose(i.Close).withHint(func() (int, int) {
+		if stopped {
+			return 0, 0
+		}
+		lower, upper := i.sizeHint()
+		lower = lower/batchSize + min(lower%batchSize, 1)
+		if upper >= 0 {
+			upper = upper/batchSize + min(upper%batchSize, 1)
+		}
+		return lower, upper
 	})
 }
 
@@ -148,6 +318,8 @@ func Batched[T any](i *Iterator[T], batchSize int) *Iterator[[]T] {
 func Repeat[T any](elem T) *Iterator[T] {
 	return New(func() (T, bool) {
 		return elem, true
+	}).withHint(func() (int, int) {
+		return math.MaxInt, -1
 	})
 }
 
@@ -163,7 +335,7 @@ func Cycle[T any](i *Iterator[T], opts ...AllocationOption) *Iterator[T] {
 	for _, opt := range opts {
 		opt(&options)
 	}
-	elems := make([]T, 0, options.preallocSize)
+	elems := make([]T, 0, i.preallocSize(options))
 	state := original
 
 	var idx int
@@ -191,7 +363,7 @@ func Cycle[T any](i *Iterator[T], opts ...AllocationOption) *Iterator[T] {
 			var zero T
 			return zero, false
 		}
-	})
+	}).onClose(i.Close)
 }
 
 // Uniq creates new iterator that yields unique elements of source iterator.
@@ -204,7 +376,7 @@ func Uniq[T comparable](i *Iterator[T], opts ...AllocationOption) *Iterator[T] {
 	for _, opt := range opts {
 		opt(&options)
 	}
-	metValues := make(map[T]struct{}, options.preallocSize)
+	metValues := make(map[T]struct{}, i.preallocSize(options))
 	return New(func() (T, bool) {
 		for {
 			v, ok := i.f()
@@ -216,7 +388,7 @@ func Uniq[T comparable](i *Iterator[T], opts ...AllocationOption) *Iterator[T] {
 				return v, true
 			}
 		}
-	})
+	}).onClose(i.Close)
 }
 
 // UniqFunc creates new iterator that yields unique elements of source iterator.
@@ -230,7 +402,7 @@ func UniqFunc[T any, U comparable](i *Iterator[T], f func(T) U, opts ...Allocati
 	for _, opt := range opts {
 		opt(&options)
 	}
-	metValues := make(map[U]struct{}, options.preallocSize)
+	metValues := make(map[U]struct{}, i.preallocSize(options))
 	return New(func() (T, bool) {
 		for {
 			v, ok := i.f()
@@ -243,14 +415,54 @@ func UniqFunc[T any, U comparable](i *Iterator[T], f func(T) U, opts ...Allocati
 				return v, true
 			}
 		}
-	})
+	}).onClose(i.Close)
 }
 
 // Sorted creates new iterator that yields elements of source iterator in ascending order.
-// Creating of the iterator requires time complexity equal to that of slices.Sort
+// Sorted is lazy: elements of source iterator are collected and sorted
+// on the first access to the elements of returned iterator.
+// Sorting requires time complexity equal to that of slices.Sort
 // and space complexity of O(n).
 func Sorted[T cmp.Ordered](i *Iterator[T], opts ...AllocationOption) *Iterator[T] {
-	values := i.Collect(opts...)
-	slices.Sort(values)
-	return NewSliceIterator(values)
+	return sortedLazily(i, slices.Sort[[]T], opts)
+}
+
+// SortedByKey creates new iterator that yields elements of source iterator
+// in ascending order of keys returned by key function.
+// Key is computed once for every element, so SortedByKey is preferable
+// to SortedBy when key function is expensive. Equal elements keep their original order.
+// SortedByKey is lazy: elements of source iterator are collected and sorted
+// on the first access to the elements of returned iterator.
+func SortedByKey[T any, K cmp.Ordered](i *Iterator[T], key func(T) K, opts ...AllocationOption) *Iterator[T] {
+	return sortedLazily(i, func(values []T) {
+		keyed := make([]Pair[K, T], len(values))
+		for idx, v := range values {
+			keyed[idx] = Pair[K, T]{
+				First:  key(v),
+				Second: v,
+			}
+		}
+		slices.SortStableFunc(keyed, func(a, b Pair[K, T]) int {
+			return cmp.Compare(a.First, b.First)
+		})
+		for idx := range keyed {
+			values[idx] = keyed[idx].Second
+		}
+	}, opts)
+}
+
+// Descending returns comparison function defining reversed order of given comparison function.
+// Descending can be used to sort elements in descending order with SortedBy.
+func Descending[T any](cmp func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		return cmp(b, a)
+	}
+}
+
+func closeAll[T any](iters []*Iterator[T]) func() {
+	return func() {
+		for _, iter := range iters {
+			iter.Close()
+		}
+	}
 }
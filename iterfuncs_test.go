@@ -1,6 +1,7 @@
 package itertools_test
 
 import (
+	"cmp"
 	"github.com/KSpaceer/itertools"
 	"math/rand"
 	"slices"
@@ -347,3 +348,201 @@ func TestFibonacciIterator_Iterfuncs(t *testing.T) {
 		}
 	})
 }
+
+func TestInterleave(t *testing.T) {
+	t.Run("interleave", func(t *testing.T) {
+		i := itertools.Interleave(
+			itertools.NewSliceIterator([]int{1, 4, 7, 9, 10}),
+			itertools.NewSliceIterator([]int{2, 5}),
+			itertools.NewSliceIterator([]int{3, 6, 8}),
+		)
+		result := i.Collect()
+
+		expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty interleave", func(t *testing.T) {
+		i := itertools.Interleave[int]()
+		if i.Next() {
+			t.Errorf("did not expect elements in empty interleave iterator; elem: %d", i.Elem())
+		}
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		i := itertools.RoundRobin(
+			[]int{2, 0},
+			itertools.NewSliceIterator([]string{"a1", "a2", "a3", "a4", "a5"}),
+			itertools.NewSliceIterator([]string{"b1", "b2"}),
+			itertools.NewSliceIterator([]string{"c1", "c2", "c3"}),
+		)
+		result := i.Collect()
+
+		expected := []string{"a1", "a2", "c1", "a3", "a4", "c2", "a5", "c3"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("intersperse", func(t *testing.T) {
+		i := itertools.Intersperse(itertools.NewSliceIterator([]string{"a", "b", "c"}), ",")
+		result := i.Collect()
+
+		expected := []string{"a", ",", "b", ",", "c"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("intersperse single element", func(t *testing.T) {
+		i := itertools.Intersperse(itertools.NewSliceIterator([]string{"a"}), ",")
+		result := i.Collect()
+
+		expected := []string{"a"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("intersperse with", func(t *testing.T) {
+		var calls int
+		i := itertools.IntersperseWith(itertools.NewSliceIterator([]int{10, 20, 30}), func() int {
+			calls++
+			return -calls
+		})
+		result := i.Collect()
+
+		expected := []int{10, -1, 20, -2, 30}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if calls != 2 {
+			t.Errorf("expected separator function to be called %d times, got %d", 2, calls)
+		}
+	})
+}
+
+func TestSorted(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{
+		{"Bob", 31},
+		{"John", 42},
+		{"Michael", 17},
+		{"Jenny", 26},
+		{"Alice", 31},
+	}
+
+	t.Run("lazy sorting", func(t *testing.T) {
+		var consumed int
+		source := itertools.NewSliceIterator([]int{3, 1, 2})
+		i := itertools.Sorted(itertools.New(func() (int, bool) {
+			if !source.Next() {
+				return 0, false
+			}
+			consumed++
+			return source.Elem(), true
+		}))
+
+		if consumed != 0 {
+			t.Errorf("expected source iterator to be untouched, but %d elements were consumed", consumed)
+		}
+
+		result := i.Collect()
+		expected := []int{1, 2, 3}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if consumed != 3 {
+			t.Errorf("expected %d consumed elements, got %d", 3, consumed)
+		}
+	})
+
+	t.Run("lazy sorted by", func(t *testing.T) {
+		ch := make(chan int)
+		i := itertools.NewChanIterator(ch).SortedBy(cmp.Compare[int])
+
+		go func() {
+			ch <- 2
+			ch <- 1
+			close(ch)
+		}()
+
+		result := i.Collect()
+		expected := []int{1, 2}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("sorted stable by", func(t *testing.T) {
+		result := itertools.NewSliceIterator(people).SortedStableBy(func(a, b person) int {
+			return cmp.Compare(a.age, b.age)
+		}).Collect()
+
+		expected := []person{
+			{"Michael", 17},
+			{"Jenny", 26},
+			{"Bob", 31},
+			{"Alice", 31},
+			{"John", 42},
+		}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("sorted by key", func(t *testing.T) {
+		var keyCalls int
+		result := itertools.SortedByKey(itertools.NewSliceIterator(people), func(p person) string {
+			keyCalls++
+			return p.name
+		}).Collect()
+
+		expected := []person{
+			{"Alice", 31},
+			{"Bob", 31},
+			{"Jenny", 26},
+			{"John", 42},
+			{"Michael", 17},
+		}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if keyCalls != len(people) {
+			t.Errorf("expected key function to be called %d times, got %d", len(people), keyCalls)
+		}
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		result := itertools.NewSliceIterator([]int{3, 5, 1, 4, 2}).
+			SortedBy(itertools.Descending(cmp.Compare[int])).
+			Collect()
+
+		expected := []int{5, 4, 3, 2, 1}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("sorted is double-ended", func(t *testing.T) {
+		i := itertools.Sorted(itertools.NewSliceIterator([]int{3, 5, 1, 4, 2}))
+		if result, ok := i.Last(); !ok || result != 5 {
+			t.Errorf("expected %d, got %d", 5, result)
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package itertools
+
+// Join creates new iterator that yields Pairs of elements of left and right iterators
+// with equal keys (inner join). Keys are computed by leftKey and rightKey respectively.
+//
+// Join is a hash join: all elements of one of the inputs (build input, see WithBuildSide)
+// are collected into hash table, which is then probed by elements of the other input.
+// By default, the input with smaller size hint is used as the build input.
+// Pairs are yielded in order of the probe input elements; matches of the same element
+// are yielded in order of the build input elements.
+// Join is lazy: the hash table is built on the first access to the elements
+// of returned iterator. Preallocation size (see WithPrealloc) is used for the hash table.
+// Build input and preallocation size are set by opts (see JoinOption).
+func Join[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	opts ...JoinOption,
+) *Iterator[Pair[L, R]] {
+	return Map(
+		hashJoin(left, right, leftKey, rightKey, false, false, opts),
+		func(p Pair[*L, *R]) Pair[L, R] {
+			return Pair[L, R]{First: *p.First, Second: *p.Second}
+		},
+	)
+}
+
+// LeftJoin works like Join (see Join), but also yields elements of left iterator
+// without matches in right iterator, paired with nil (left outer join).
+// Unmatched elements of the build input are yielded after all other pairs.
+func LeftJoin[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	opts ...JoinOption,
+) *Iterator[Pair[L, *R]] {
+	return Map(
+		hashJoin(left, right, leftKey, rightKey, true, false, opts),
+		func(p Pair[*L, *R]) Pair[L, *R] {
+			return Pair[L, *R]{First: *p.First, Second: p.Second}
+		},
+	)
+}
+
+// RightJoin works like Join (see Join), but also yields elements of right iterator
+// without matches in left iterator, paired with nil (right outer join).
+// Unmatched elements of the build input are yielded after all other pairs.
+func RightJoin[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	opts ...JoinOption,
+) *Iterator[Pair[*L, R]] {
+	return Map(
+		hashJoin(left, right, leftKey, rightKey, false, true, opts),
+		func(p Pair[*L, *R]) Pair[*L, R] {
+			return Pair[*L, R]{First: p.First, Second: *p.Second}
+		},
+	)
+}
+
+// FullJoin works like Join (see Join), but also yields elements of both iterators
+// without matches in the other iterator, paired with nil (full outer join).
+// Unmatched elements of the build input are yielded after all other pairs.
+func FullJoin[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	opts ...JoinOption,
+) *Iterator[Pair[*L, *R]] {
+	return hashJoin(left, right, leftKey, rightKey, true, true, opts)
+}
+
+// SemiJoin creates new iterator that yields elements of left iterator
+// which have at least one element with equal key in right iterator.
+// Keys of right iterator elements are collected into hash set on the first access
+// to the elements of returned iterator. Preallocation size (see WithPrealloc) is used for the hash set.
+func SemiJoin[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	opts ...AllocationOption,
+) *Iterator[L] {
+	return filterByKeys(left, right, leftKey, rightKey, true, opts)
+}
+
+// AntiJoin creates new iterator that yields elements of left iterator
+// which have no elements with equal key in right iterator.
+// Keys of right iterator elements are collected into hash set on the first access
+// to the elements of returned iterator. Preallocation size (see WithPrealloc) is used for the hash set.
+func AntiJoin[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	opts ...AllocationOption,
+) *Iterator[L] {
+	return filterByKeys(left, right, leftKey, rightKey, false, opts)
+}
+
+func filterByKeys[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	keepMatched bool,
+	opts []AllocationOption,
+) *Iterator[L] {
+	var options allocOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	var keys map[K]struct{}
+	return left.Filter(func(v L) bool {
+		if keys == nil {
+			keys = make(map[K]struct{}, right.preallocSize(options))
+			for r, ok := right.f(); ok; r, ok = right.f() {
+				keys[rightKey(r)] = struct{}{}
+			}
+		}
+		_, matched := keys[leftKey(v)]
+		return matched == keepMatched
+	}).onClose(right.Close)
+}
+
+// hashJoin joins left and right iterators, yielding pairs of pointers to matched elements.
+// If keepLeft or keepRight is true, unmatched elements of corresponding input
+// are yielded with nil in place of the other element.
+func hashJoin[L, R any, K comparable](
+	left *Iterator[L],
+	right *Iterator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	keepLeft, keepRight bool,
+	opts []JoinOption,
+) *Iterator[Pair[*L, *R]] {
+	var options joinOptions
+	for _, opt := range opts {
+		opt.applyJoin(&options)
+	}
+
+	side := options.buildSide
+	if side == AutoSide {
+		side = RightSide
+		_, leftUpper := left.sizeHint()
+		_, rightUpper := right.sizeHint()
+		if leftUpper >= 0 && (rightUpper < 0 || leftUpper < rightUpper) {
+			side = LeftSide
+		}
+	}
+
+	if side == LeftSide {
+		return Map(
+			joinProbe(right, left, rightKey, leftKey, keepRight, keepLeft, options.alloc),
+			func(p Pair[*R, *L]) Pair[*L, *R] {
+				return Pair[*L, *R]{First: p.Second, Second: p.First}
+			},
+		)
+	}
+	return joinProbe(left, right, leftKey, rightKey, keepLeft, keepRight, options.alloc)
+}
+
+// joinProbe builds hash table from build iterator and probes it with elements of probe iterator.
+func joinProbe[P, B any, K comparable](
+	probe *Iterator[P],
+	build *Iterator[B],
+	probeKey func(P) K,
+	buildKey func(B) K,
+	keepProbe, keepBuild bool,
+	options allocOptions,
+) *Iterator[Pair[*P, *B]] {
+	type entry struct {
+		value   B
+		matched bool
+	}
+	var (
+		table     map[K][]*entry
+		entries   []*entry
+		current   *P
+		matches   []*entry
+		unmatched int
+		probing   = true
+	)
+	return New(func() (Pair[*P, *B], bool) {
+		if table == nil {
+			table = make(map[K][]*entry, build.preallocSize(options))
+			for v, ok := build.f(); ok; v, ok = build.f() {
+				e := &entry{value: v}
+				key := buildKey(v)
+				table[key] = append(table[key], e)
+				if keepBuild {
+					entries = append(entries, e)
+				}
+			}
+		}
+
+		for probing {
+			if len(matches) > 0 {
+				e := matches[0]
+				matches = matches[1:]
+				e.matched = true
+				return Pair[*P, *B]{First: current, Second: &e.value}, true
+			}
+			v, ok := probe.f()
+			if !ok {
+				probing = false
+				break
+			}
+			current = &v
+			matches = table[probeKey(v)]
+			if len(matches) == 0 && keepProbe {
+				return Pair[*P, *B]{First: current}, true
+			}
+		}
+
+		for unmatched < len(entries) {
+			e := entries[unmatched]
+			unmatched++
+			if !e.matched {
+				return Pair[*P, *B]{Second: &e.value}, true
+			}
+		}
+		return Pair[*P, *B]{}, false
+	}).onClose(probe.Close, build.Close)
+}
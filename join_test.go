@@ -0,0 +1,208 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"testing"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+type order struct {
+	userID int
+	item   string
+}
+
+func formatJoined(u *user, o *order) string {
+	userName, item := "-", "-"
+	if u != nil {
+		userName = u.name
+	}
+	if o != nil {
+		item = o.item
+	}
+	return userName + ":" + item
+}
+
+func TestJoin(t *testing.T) {
+	users := []user{{1, "alice"}, {2, "bob"}, {3, "carol"}}
+	orders := []order{{1, "book"}, {3, "pen"}, {1, "lamp"}, {4, "cup"}}
+	userID := func(u user) int { return u.id }
+	orderUserID := func(o order) int { return o.userID }
+
+	t.Run("inner", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.Join(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+				itertools.WithBuildSide(itertools.RightSide),
+			),
+			func(p itertools.Pair[user, order]) string { return formatJoined(&p.First, &p.Second) },
+		).Collect()
+
+		expected := []string{"alice:book", "alice:lamp", "carol:pen"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("inner with smaller left side", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.Join(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+			),
+			func(p itertools.Pair[user, order]) string { return formatJoined(&p.First, &p.Second) },
+		).Collect()
+
+		expected := []string{"alice:book", "carol:pen", "alice:lamp"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("left", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.LeftJoin(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+				itertools.WithBuildSide(itertools.RightSide),
+			),
+			func(p itertools.Pair[user, *order]) string { return formatJoined(&p.First, p.Second) },
+		).Collect()
+
+		expected := []string{"alice:book", "alice:lamp", "bob:-", "carol:pen"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("left with left build side", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.LeftJoin(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+				itertools.WithBuildSide(itertools.LeftSide),
+			),
+			func(p itertools.Pair[user, *order]) string { return formatJoined(&p.First, p.Second) },
+		).Collect()
+
+		expected := []string{"alice:book", "carol:pen", "alice:lamp", "bob:-"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("right", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.RightJoin(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+			),
+			func(p itertools.Pair[*user, order]) string { return formatJoined(p.First, &p.Second) },
+		).Collect()
+
+		expected := []string{"alice:book", "carol:pen", "alice:lamp", "-:cup"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("full", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.FullJoin(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+				itertools.WithBuildSide(itertools.RightSide),
+			),
+			func(p itertools.Pair[*user, *order]) string { return formatJoined(p.First, p.Second) },
+		).Collect()
+
+		expected := []string{"alice:book", "alice:lamp", "bob:-", "carol:pen", "-:cup"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty side", func(t *testing.T) {
+		result := itertools.FullJoin(
+			itertools.NewSliceIterator(users),
+			itertools.NewSliceIterator([]order{}),
+			userID,
+			orderUserID,
+			itertools.WithPrealloc(16),
+		).Count()
+
+		if result != len(users) {
+			t.Errorf("expected %d, got %d", len(users), result)
+		}
+	})
+
+	t.Run("semi", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.SemiJoin(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+			),
+			func(u user) string { return u.name },
+		).Collect()
+
+		expected := []string{"alice", "carol"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("anti", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.AntiJoin(
+				itertools.NewSliceIterator(users),
+				itertools.NewSliceIterator(orders),
+				userID,
+				orderUserID,
+			),
+			func(u user) string { return u.name },
+		).Collect()
+
+		expected := []string{"bob"}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		var leftClosed, rightClosed bool
+		left := itertools.NewWithClose(func() (int, bool) { return 0, false }, func() { leftClosed = true })
+		right := itertools.NewWithClose(func() (int, bool) { return 0, false }, func() { rightClosed = true })
+
+		itertools.Join(left, right, func(v int) int { return v }, func(v int) int { return v }).Close()
+
+		if !leftClosed || !rightClosed {
+			t.Errorf("expected both sources to be closed, got left: %v, right: %v", leftClosed, rightClosed)
+		}
+	})
+}
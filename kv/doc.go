@@ -0,0 +1,4 @@
+// Package kv provides helpers for iterators of key-value pairs
+// (e.g. produced by itertools.NewMapIterator or itertools.Zip),
+// where the First value of itertools.Pair is a key and the Second one is a value.
+package kv
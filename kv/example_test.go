@@ -0,0 +1,36 @@
+package kv_test
+
+import (
+	"fmt"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/kv"
+)
+
+func ExampleCollectMap() {
+	sales := itertools.NewSliceIterator([]itertools.Pair[string, int]{
+		{First: "apple", Second: 3},
+		{First: "pear", Second: 2},
+		{First: "apple", Second: 5},
+	})
+
+	totals := kv.CollectMap(sales, func(_ string, old, new int) int { return old + new })
+
+	fmt.Println(totals["apple"], totals["pear"])
+	// Output:
+	// 8 2
+}
+
+func ExampleSortedByKey() {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	iter := kv.MapValues(kv.SortedByKey(itertools.NewMapIterator(m)), func(v int) int { return v * v })
+
+	for iter.Next() {
+		k, v := iter.Elem().Unpack()
+		fmt.Println(k, v)
+	}
+	// Output:
+	// a 1
+	// b 4
+	// c 9
+}
@@ -0,0 +1,111 @@
+package kv
+
+import (
+	"cmp"
+	"github.com/KSpaceer/itertools"
+)
+
+// Keys creates new iterator that yields keys of key-value pairs.
+func Keys[K, V any](i *itertools.Iterator[itertools.Pair[K, V]]) *itertools.Iterator[K] {
+	return itertools.Map(i, func(p itertools.Pair[K, V]) K {
+		return p.First
+	})
+}
+
+// Values creates new iterator that yields values of key-value pairs.
+func Values[K, V any](i *itertools.Iterator[itertools.Pair[K, V]]) *itertools.Iterator[V] {
+	return itertools.Map(i, func(p itertools.Pair[K, V]) V {
+		return p.Second
+	})
+}
+
+// MapValues creates new iterator that yields key-value pairs
+// with values transformed by mapper and keys left intact.
+func MapValues[K, V, U any](
+	i *itertools.Iterator[itertools.Pair[K, V]],
+	mapper func(V) U,
+) *itertools.Iterator[itertools.Pair[K, U]] {
+	return itertools.Map(i, func(p itertools.Pair[K, V]) itertools.Pair[K, U] {
+		return itertools.Pair[K, U]{
+			First:  p.First,
+			Second: mapper(p.Second),
+		}
+	})
+}
+
+// MapKeys creates new iterator that yields key-value pairs
+// with keys transformed by mapper and values left intact.
+func MapKeys[K, V, U any](
+	i *itertools.Iterator[itertools.Pair[K, V]],
+	mapper func(K) U,
+) *itertools.Iterator[itertools.Pair[U, V]] {
+	return itertools.Map(i, func(p itertools.Pair[K, V]) itertools.Pair[U, V] {
+		return itertools.Pair[U, V]{
+			First:  mapper(p.First),
+			Second: p.Second,
+		}
+	})
+}
+
+// FilterKeys creates new iterator that yields only key-value pairs
+// with keys for which function f returns true.
+func FilterKeys[K, V any](
+	i *itertools.Iterator[itertools.Pair[K, V]],
+	f func(K) bool,
+) *itertools.Iterator[itertools.Pair[K, V]] {
+	return i.Filter(func(p itertools.Pair[K, V]) bool {
+		return f(p.First)
+	})
+}
+
+// FilterValues creates new iterator that yields only key-value pairs
+// with values for which function f returns true.
+func FilterValues[K, V any](
+	i *itertools.Iterator[itertools.Pair[K, V]],
+	f func(V) bool,
+) *itertools.Iterator[itertools.Pair[K, V]] {
+	return i.Filter(func(p itertools.Pair[K, V]) bool {
+		return f(p.Second)
+	})
+}
+
+// Swap creates new iterator that yields key-value pairs with keys and values swapped.
+func Swap[K, V any](i *itertools.Iterator[itertools.Pair[K, V]]) *itertools.Iterator[itertools.Pair[V, K]] {
+	return itertools.Map(i, itertools.Pair[K, V].Swap)
+}
+
+// CollectMap returns all key-value pairs of iterator as map.
+// If several pairs have equal keys, function resolve is called with the key,
+// the value already stored in map and the new value, and its result is stored in map.
+// If resolve is nil, the last value for the key is stored.
+func CollectMap[K comparable, V any](
+	i *itertools.Iterator[itertools.Pair[K, V]],
+	resolve func(key K, old, new V) V,
+) map[K]V {
+	var size int
+	if lower, upper := i.SizeHint(); upper >= 0 {
+		size = lower
+	}
+	m := make(map[K]V, size)
+	for i.Next() {
+		k, v := i.Elem().Unpack()
+		if old, ok := m[k]; ok && resolve != nil {
+			v = resolve(k, old, v)
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// SortedByKey creates new iterator that yields key-value pairs of source iterator
+// in ascending order of keys. Pairs with equal keys keep their original order.
+// SortedByKey is lazy: pairs of source iterator are collected and sorted
+// on the first access to the elements of returned iterator.
+func SortedByKey[K cmp.Ordered, V any](
+	i *itertools.Iterator[itertools.Pair[K, V]],
+	opts ...itertools.AllocationOption,
+) *itertools.Iterator[itertools.Pair[K, V]] {
+	return i.SortedStableBy(func(a, b itertools.Pair[K, V]) int {
+		return cmp.Compare(a.First, b.First)
+	}, opts...)
+}
@@ -0,0 +1,118 @@
+package kv_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/kv"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func pairs() *itertools.Iterator[itertools.Pair[string, int]] {
+	return itertools.Zip(
+		itertools.NewSliceIterator([]string{"b", "a", "c", "a"}),
+		itertools.NewSliceIterator([]int{2, 1, 3, 4}),
+	)
+}
+
+func TestKV(t *testing.T) {
+	t.Run("keys", func(t *testing.T) {
+		result := kv.Keys(pairs()).Collect()
+		if expected := []string{"b", "a", "c", "a"}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("values", func(t *testing.T) {
+		result := kv.Values(pairs()).Collect()
+		if expected := []int{2, 1, 3, 4}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("map values", func(t *testing.T) {
+		result := kv.Values(kv.MapValues(pairs(), func(v int) int { return v * 10 })).Collect()
+		if expected := []int{20, 10, 30, 40}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		result := kv.Keys(kv.MapKeys(pairs(), strings.ToUpper)).Collect()
+		if expected := []string{"B", "A", "C", "A"}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("filter keys", func(t *testing.T) {
+		result := kv.Values(kv.FilterKeys(pairs(), func(k string) bool { return k == "a" })).Collect()
+		if expected := []int{1, 4}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("filter values", func(t *testing.T) {
+		result := kv.Keys(kv.FilterValues(pairs(), func(v int) bool { return v%2 == 0 })).Collect()
+		if expected := []string{"b", "a"}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		result := kv.Keys(kv.Swap(pairs())).Collect()
+		if expected := []int{2, 1, 3, 4}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("collect map", func(t *testing.T) {
+		result := kv.CollectMap(pairs(), nil)
+		if expected := map[string]int{"a": 4, "b": 2, "c": 3}; !mapEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("collect map with resolve", func(t *testing.T) {
+		result := kv.CollectMap(pairs(), func(_ string, old, new int) int { return old + new })
+		if expected := map[string]int{"a": 5, "b": 2, "c": 3}; !mapEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("sorted by key", func(t *testing.T) {
+		result := kv.SortedByKey(pairs()).Collect()
+		expected := []itertools.Pair[string, int]{
+			{First: "a", Second: 1},
+			{First: "a", Second: 4},
+			{First: "b", Second: 2},
+			{First: "c", Second: 3},
+		}
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestTuples(t *testing.T) {
+	p := itertools.Pair[string, int]{First: "a", Second: 1}.Swap()
+	if p.First != 1 || p.Second != "a" {
+		t.Errorf("expected {1 a}, got %v", p)
+	}
+
+	first, second, third := itertools.Triple[int, string, bool]{First: 1, Second: "b", Third: true}.Unpack()
+	if first != 1 || second != "b" || !third {
+		t.Errorf("expected (1, b, true), got (%v, %v, %v)", first, second, third)
+	}
+}
+
+func mapEqual[K comparable, V comparable](a, b map[K]V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package itertools
+
+import "cmp"
+
+// Option represents optional value: it either contains some value or is empty.
+// Option is returned by functions which may have no meaningful result
+// (e.g. MaxOpt for empty iterator), so that the absent result
+// can't be mistaken for a zero value.
+// The zero value of Option is empty.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some creates Option containing given value.
+func Some[T any](v T) Option[T] {
+	return Option[T]{
+		value: v,
+		ok:    true,
+	}
+}
+
+// None creates empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// optionOf creates Option from value and boolean value indicating if the value is valid.
+func optionOf[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// Get returns contained value and boolean value indicating if Option contains value.
+// If Option is empty, returned value is zero value of T.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// IsSome returns true if Option contains value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if Option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// OrElse returns contained value or v if Option is empty.
+func (o Option[T]) OrElse(v T) T {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// Map returns Option containing result of f applied to contained value.
+// If Option is empty, f is not called and empty Option is returned.
+// To change type of contained value use MapOption.
+func (o Option[T]) Map(f func(T) T) Option[T] {
+	return MapOption(o, f)
+}
+
+// MapOption returns Option containing result of f applied to value contained in o.
+// If o is empty, f is not called and empty Option is returned.
+func MapOption[T, U any](o Option[T], f func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
+
+// MaxOpt works like Max (see Iterator.Max), but returns empty Option for empty iterator.
+func (i *Iterator[T]) MaxOpt(f func(T, T) int) Option[T] {
+	if !i.Next() {
+		return None[T]()
+	}
+	maxValue := i.Elem()
+	for i.Next() {
+		v := i.Elem()
+		if f(maxValue, v) < 0 {
+			maxValue = v
+		}
+	}
+	return Some(maxValue)
+}
+
+// FirstOpt returns the next element of iterator or empty Option if iterator is empty.
+func (i *Iterator[T]) FirstOpt() Option[T] {
+	if !i.Next() {
+		return None[T]()
+	}
+	return Some(i.Elem())
+}
+
+// NthOpt works like Nth (see Iterator.Nth), but returns Option.
+func (i *Iterator[T]) NthOpt(n int) Option[T] {
+	return optionOf(i.Nth(n))
+}
+
+// LastOpt works like Last (see Iterator.Last), but returns Option.
+func (i *Iterator[T]) LastOpt() Option[T] {
+	return optionOf(i.Last())
+}
+
+// MaxOpt returns max value of iterator or empty Option if iterator is empty.
+func MaxOpt[T cmp.Ordered](i *Iterator[T]) Option[T] {
+	return i.MaxOpt(cmp.Compare[T])
+}
+
+// MinOpt returns min value of iterator or empty Option if iterator is empty.
+func MinOpt[T cmp.Ordered](i *Iterator[T]) Option[T] {
+	return i.MaxOpt(Descending(cmp.Compare[T]))
+}
+
+// FindOpt works like Find (see Find), but returns Option.
+func FindOpt[T any](i *Iterator[T], f func(T) bool) Option[T] {
+	return optionOf(Find(i, f))
+}
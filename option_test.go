@@ -0,0 +1,142 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"strconv"
+	"testing"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		o := itertools.Some(0)
+
+		if v, ok := o.Get(); !ok || v != 0 {
+			t.Errorf("expected (0, true), got (%d, %v)", v, ok)
+		}
+		if !o.IsSome() || o.IsNone() {
+			t.Errorf("expected option to contain value")
+		}
+		if v := o.OrElse(5); v != 0 {
+			t.Errorf("expected 0, got %d", v)
+		}
+		if v := o.Map(func(n int) int { return n + 1 }).OrElse(5); v != 1 {
+			t.Errorf("expected 1, got %d", v)
+		}
+		if v := itertools.MapOption(o, strconv.Itoa).OrElse(""); v != "0" {
+			t.Errorf("expected %q, got %q", "0", v)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		var o itertools.Option[int]
+
+		if _, ok := o.Get(); ok {
+			t.Errorf("expected zero Option to be empty")
+		}
+		if o.IsSome() || !o.IsNone() {
+			t.Errorf("expected option to be empty")
+		}
+		if v := o.OrElse(5); v != 5 {
+			t.Errorf("expected 5, got %d", v)
+		}
+		called := false
+		o = itertools.None[int]().Map(func(n int) int {
+			called = true
+			return n
+		})
+		if called || o.IsSome() {
+			t.Errorf("expected mapper not to be called for empty Option")
+		}
+	})
+
+	tcases := []struct {
+		name     string
+		values   []int
+		f        func(*itertools.Iterator[int]) itertools.Option[int]
+		expected itertools.Option[int]
+	}{
+		{
+			name:     "max",
+			values:   []int{3, 0, 7, 2},
+			f:        itertools.MaxOpt[int],
+			expected: itertools.Some(7),
+		},
+		{
+			name:     "max empty",
+			f:        itertools.MaxOpt[int],
+			expected: itertools.None[int](),
+		},
+		{
+			name:     "min",
+			values:   []int{3, 0, 7, 2},
+			f:        itertools.MinOpt[int],
+			expected: itertools.Some(0),
+		},
+		{
+			name:     "min empty",
+			f:        itertools.MinOpt[int],
+			expected: itertools.None[int](),
+		},
+		{
+			name:     "first",
+			values:   []int{3, 0, 7, 2},
+			f:        (*itertools.Iterator[int]).FirstOpt,
+			expected: itertools.Some(3),
+		},
+		{
+			name:     "first empty",
+			f:        (*itertools.Iterator[int]).FirstOpt,
+			expected: itertools.None[int](),
+		},
+		{
+			name:     "nth",
+			values:   []int{3, 0, 7, 2},
+			f:        func(i *itertools.Iterator[int]) itertools.Option[int] { return i.NthOpt(1) },
+			expected: itertools.Some(0),
+		},
+		{
+			name:     "nth out of range",
+			values:   []int{3, 0, 7, 2},
+			f:        func(i *itertools.Iterator[int]) itertools.Option[int] { return i.NthOpt(4) },
+			expected: itertools.None[int](),
+		},
+		{
+			name:     "last",
+			values:   []int{3, 0, 7, 2},
+			f:        (*itertools.Iterator[int]).LastOpt,
+			expected: itertools.Some(2),
+		},
+		{
+			name:     "last empty",
+			f:        (*itertools.Iterator[int]).LastOpt,
+			expected: itertools.None[int](),
+		},
+		{
+			name:   "find",
+			values: []int{3, 0, 7, 2},
+			f: func(i *itertools.Iterator[int]) itertools.Option[int] {
+				return itertools.FindOpt(i, func(n int) bool { return n < 1 })
+			},
+			expected: itertools.Some(0),
+		},
+		{
+			name:   "find missing",
+			values: []int{3, 0, 7, 2},
+			f: func(i *itertools.Iterator[int]) itertools.Option[int] {
+				return itertools.FindOpt(i, func(n int) bool { return n < 0 })
+			},
+			expected: itertools.None[int](),
+		},
+	}
+
+	for _, tc := range tcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.f(itertools.NewSliceIterator(tc.values))
+
+			if result != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
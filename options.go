@@ -1,7 +1,10 @@
 package itertools
 
+import "github.com/KSpaceer/itertools/clock"
+
 type allocOptions struct {
 	preallocSize int
+	reuseBuffer  bool
 }
 
 // AllocationOption allows to manipulate allocations in iteration methods/functions.
@@ -13,3 +16,74 @@ func WithPrealloc(prealloc int) AllocationOption {
 		o.preallocSize = prealloc
 	}
 }
+
+// WithBufferReuse makes iterators yielding slices reuse the same buffer for every element
+// instead of allocating new slice each time.
+// Yielded slice is valid only until the next iteration, so it must be copied to be retained.
+func WithBufferReuse() AllocationOption {
+	return func(o *allocOptions) {
+		o.reuseBuffer = true
+	}
+}
+
+type joinOptions struct {
+	alloc     allocOptions
+	buildSide JoinSide
+}
+
+// JoinOption allows to configure hash joins (see Join).
+// Besides WithBuildSide, every AllocationOption is a JoinOption.
+type JoinOption interface {
+	applyJoin(options *joinOptions)
+}
+
+func (opt AllocationOption) applyJoin(o *joinOptions) {
+	opt(&o.alloc)
+}
+
+// JoinSide defines input of hash join used to build hash table.
+type JoinSide int
+
+const (
+	// AutoSide means that hash table is built from the input with smaller size hint
+	// or from the right input if sizes are unknown.
+	AutoSide JoinSide = iota
+	// LeftSide means that hash table is built from the left input.
+	LeftSide
+	// RightSide means that hash table is built from the right input.
+	RightSide
+)
+
+func (side JoinSide) applyJoin(o *joinOptions) {
+	o.buildSide = side
+}
+
+// WithBuildSide sets input of hash join (see Join) to build hash table from.
+// The whole build input is kept in memory, so it should be the smaller one.
+func WithBuildSide(side JoinSide) JoinOption {
+	return side
+}
+
+type timingOptions struct {
+	clock clock.Clock
+}
+
+// TimingOption allows to configure time-based iterators (e.g. BatchedTimeout).
+type TimingOption func(options *timingOptions)
+
+// WithClock sets clock used to measure time. By default, the real clock is used.
+// WithClock allows to test time-based iterators deterministically with clock.Fake.
+func WithClock(c clock.Clock) TimingOption {
+	return func(o *timingOptions) {
+		o.clock = c
+	}
+}
+
+func newTimingOptions(opts []TimingOption) timingOptions {
+	var options timingOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	options.clock = clock.OrReal(options.clock)
+	return options
+}
@@ -18,3 +18,23 @@ type Enumeration[T any] Pair[T, int]
 func (p Enumeration[T]) Unpack() (T, int) {
 	return p.First, p.Second
 }
+
+// Swap returns Pair with swapped values.
+func (p Pair[T, U]) Swap() Pair[U, T] {
+	return Pair[U, T]{
+		First:  p.Second,
+		Second: p.First,
+	}
+}
+
+// Triple is 3-size tuple of heterogeneous values.
+type Triple[T, U, V any] struct {
+	First  T
+	Second U
+	Third  V
+}
+
+// Unpack returns values of Triple as tuple.
+func (t Triple[T, U, V]) Unpack() (T, U, V) {
+	return t.First, t.Second, t.Third
+}
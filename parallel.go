@@ -0,0 +1,144 @@
+package itertools
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ParallelReduce reduces elements of iterator concurrently.
+// Elements are split into chunks of size chunkSize (see Batched), every chunk is reduced
+// by one of workers goroutines with reduceFn, starting with identity, and partial results
+// are combined with combineFn in order of chunks, starting with identity as well.
+// Thus, identity must be an identity element of combineFn (e.g. 0 for sum),
+// and combineFn must be associative, but it does not have to be commutative.
+// Also reduceFn must be consistent with combineFn, i.e. reduceFn(acc, x) must be equal
+// to combineFn(acc, reduceFn(identity, x)) for any acc and x (e.g. reducing and combining by addition).
+// If these requirements hold, the result is the same as of sequential Reduce for any amount of workers.
+// Source iterator is closed when ParallelReduce returns.
+// If workers is not positive, runtime.GOMAXPROCS(0) workers are used.
+// If chunkSize is not positive, ParallelReduce returns identity.
+// Panic in reduceFn, combineFn or source iterator is propagated to the caller
+// after all workers are stopped.
+func ParallelReduce[T, U any](
+	i *Iterator[T],
+	workers int,
+	chunkSize int,
+	identity U,
+	reduceFn func(acc U, elem T) U,
+	combineFn func(U, U) U,
+) U {
+	if chunkSize <= 0 {
+		i.Close()
+		return identity
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	type chunk struct {
+		idx    int
+		values []T
+	}
+	type partial struct {
+		idx        int
+		value      U
+		panicValue any
+		panicked   bool
+	}
+
+	var (
+		chunks   = make(chan chunk, workers)
+		partials = make(chan partial, workers)
+		stop     = make(chan struct{})
+		wg       sync.WaitGroup
+	)
+
+	// protect runs f, turning its panic into partial result with panic value.
+	protect := func(idx int, f func() U) (p partial) {
+		defer func() {
+			if r := recover(); r != nil {
+				p = partial{idx: idx, panicValue: r, panicked: true}
+			}
+		}()
+		return partial{idx: idx, value: f()}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(chunks)
+		defer i.Close()
+		defer func() {
+			if r := recover(); r != nil {
+				partials <- partial{panicValue: r, panicked: true}
+			}
+		}()
+		batches := Batched(i, chunkSize)
+		for idx := 0; batches.Next(); idx++ {
+			select {
+			case chunks <- chunk{idx: idx, values: batches.Elem()}:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chunks {
+				partials <- protect(c.idx, func() U {
+					acc := identity
+					for _, v := range c.values {
+						acc = reduceFn(acc, v)
+					}
+					return acc
+				})
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(partials)
+	}()
+
+	var (
+		acc     = identity
+		next    int
+		pending = make(map[int]U)
+		failure *partial
+	)
+	fail := func(p partial) {
+		failure = &p
+		close(stop)
+	}
+	// partials are drained completely even after failure to let all goroutines finish.
+	for p := range partials {
+		if failure != nil {
+			continue
+		}
+		if p.panicked {
+			fail(p)
+			continue
+		}
+		pending[p.idx] = p.value
+		for v, ok := pending[next]; ok && failure == nil; v, ok = pending[next] {
+			delete(pending, next)
+			combined := protect(next, func() U {
+				return combineFn(acc, v)
+			})
+			next++
+			if combined.panicked {
+				fail(combined)
+			} else {
+				acc = combined.value
+			}
+		}
+	}
+	if failure != nil {
+		panic(failure.panicValue)
+	}
+	return acc
+}
@@ -0,0 +1,145 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"strconv"
+	"testing"
+)
+
+func TestParallelReduce(t *testing.T) {
+	t.Run("sum", func(t *testing.T) {
+		result := itertools.ParallelReduce(
+			itertools.Range(0, 10000, 1),
+			4,
+			100,
+			0,
+			func(acc, n int) int { return acc + n },
+			func(a, b int) int { return a + b },
+		)
+
+		if expected := 10000 * 9999 / 2; result != expected {
+			t.Errorf("expected %d, got %d", expected, result)
+		}
+	})
+
+	t.Run("non-commutative combine", func(t *testing.T) {
+		concat := func(workers int) string {
+			return itertools.ParallelReduce(
+				itertools.Range(0, 500, 1),
+				workers,
+				7,
+				"",
+				func(acc string, n int) string { return acc + strconv.Itoa(n) + "," },
+				func(a, b string) string { return a + b },
+			)
+		}
+
+		var expected string
+		for n := range 500 {
+			expected += strconv.Itoa(n) + ","
+		}
+		for _, workers := range []int{0, 1, 2, 8, 32} {
+			if result := concat(workers); result != expected {
+				t.Errorf("workers %d: result differs from sequential one", workers)
+			}
+		}
+	})
+
+	t.Run("invalid chunk size", func(t *testing.T) {
+		result := itertools.ParallelReduce(
+			itertools.Range(0, 10, 1),
+			2,
+			0,
+			-1,
+			func(acc, n int) int { return acc + n },
+			func(a, b int) int { return a + b },
+		)
+
+		if result != -1 {
+			t.Errorf("expected identity, got %d", result)
+		}
+	})
+
+	t.Run("reduce panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "bad element" {
+				t.Errorf("expected panic %q, got %v", "bad element", r)
+			}
+		}()
+
+		itertools.ParallelReduce(
+			itertools.Range(0, 1000, 1),
+			4,
+			10,
+			0,
+			func(acc, n int) int {
+				if n == 537 {
+					panic("bad element")
+				}
+				return acc + n
+			},
+			func(a, b int) int { return a + b },
+		)
+		t.Errorf("expected panic")
+	})
+
+	t.Run("source is closed after panic", func(t *testing.T) {
+		var (
+			count  int
+			closed bool
+		)
+		source := itertools.NewWithClose(func() (int, bool) {
+			count++
+			return count, count <= 1000
+		}, func() { closed = true })
+
+		defer func() {
+			if r := recover(); r != "bad element" {
+				t.Errorf("expected panic %q, got %v", "bad element", r)
+			}
+			if !closed {
+				t.Errorf("expected source to be closed")
+			}
+		}()
+
+		itertools.ParallelReduce(
+			source,
+			4,
+			10,
+			0,
+			func(acc, n int) int {
+				if n == 537 {
+					panic("bad element")
+				}
+				return acc + n
+			},
+			func(a, b int) int { return a + b },
+		)
+		t.Errorf("expected panic")
+	})
+
+	t.Run("source panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "source failure" {
+				t.Errorf("expected panic %q, got %v", "source failure", r)
+			}
+		}()
+
+		var count int
+		itertools.ParallelReduce(
+			itertools.New(func() (int, bool) {
+				count++
+				if count > 50 {
+					panic("source failure")
+				}
+				return count, true
+			}),
+			4,
+			10,
+			0,
+			func(acc, n int) int { return acc + n },
+			func(a, b int) int { return a + b },
+		)
+		t.Errorf("expected panic")
+	})
+}
@@ -0,0 +1,91 @@
+package itertools
+
+import "context"
+
+// Prefetch creates new iterator that yields elements of source iterator,
+// reading them in background goroutine into buffer of size n ahead of the consumer.
+// Prefetch is useful for slow sources (e.g. network or disk reads),
+// letting the source produce next elements while the consumer processes the current one.
+// The goroutine is started on the first access to the elements of returned iterator
+// and is stopped when source iterator is exhausted or returned iterator is closed.
+// Close waits for the goroutine to stop before closing source iterator.
+// Panic in source iterator is propagated to the consumer.
+func Prefetch[T any](i *Iterator[T], n int) *Iterator[T] {
+	return PrefetchContext(context.Background(), i, n)
+}
+
+// PrefetchContext works like Prefetch (see Prefetch), but also stops the iteration
+// when ctx is done.
+func PrefetchContext[T any](ctx context.Context, i *Iterator[T], n int) *Iterator[T] {
+	var (
+		zero       T
+		ch         chan T
+		done       chan struct{}
+		cancel     context.CancelFunc
+		panicValue any
+	)
+	start := func() {
+		var prefetchCtx context.Context
+		prefetchCtx, cancel = context.WithCancel(ctx)
+		ch = make(chan T, max(n, 0))
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			defer close(ch)
+			defer func() {
+				panicValue = recover()
+			}()
+			for {
+				v, ok := i.f()
+				if !ok {
+					return
+				}
+				select {
+				case ch <- v:
+				case <-prefetchCtx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return New(func() (T, bool) {
+		if ch == nil {
+			start()
+		}
+		if ctx.Err() != nil {
+			return zero, false
+		}
+		v, ok := <-ch
+		if !ok && panicValue != nil {
+			p := panicValue
+			panicValue = nil
+			panic(p)
+		}
+		return v, ok
+	}).onClose(func() {
+		if ch != nil {
+			cancel()
+			<-done
+		}
+	}, i.Close)
+}
+
+// ToChan returns channel yielding elements of iterator. Elements are sent to the channel
+// by background goroutine, which closes the channel and the iterator when the iterator
+// is exhausted or ctx is done. Parameter buf sets capacity of the channel.
+// Consumer must either receive all elements or cancel ctx to stop the goroutine.
+func ToChan[T any](ctx context.Context, i *Iterator[T], buf int) <-chan T {
+	ch := make(chan T, max(buf, 0))
+	go func() {
+		defer close(ch)
+		defer i.Close()
+		for i.Next() {
+			select {
+			case ch <- i.Elem():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
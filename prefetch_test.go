@@ -0,0 +1,133 @@
+package itertools_test
+
+import (
+	"context"
+	"github.com/KSpaceer/itertools"
+	"testing"
+)
+
+func TestPrefetch(t *testing.T) {
+	t.Run("yields all elements in order", func(t *testing.T) {
+		result := itertools.Prefetch(itertools.Range(0, 100, 1), 8).Collect()
+
+		expected := itertools.Range(0, 100, 1).Collect()
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("unbuffered", func(t *testing.T) {
+		result := itertools.Prefetch(itertools.NewSliceIterator([]int{1, 2, 3}), 0).Collect()
+
+		if expected := []int{1, 2, 3}; !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("close stops source", func(t *testing.T) {
+		var closed bool
+		source := itertools.NewWithClose(func() (int, bool) {
+			return 1, true
+		}, func() { closed = true })
+
+		iter := itertools.Prefetch(source, 4)
+		if !iter.Next() || iter.Elem() != 1 {
+			t.Fatalf("expected element 1")
+		}
+		iter.Close()
+		iter.Close()
+
+		if !closed {
+			t.Errorf("expected source to be closed")
+		}
+		if iter.Next() {
+			t.Errorf("expected no elements after Close")
+		}
+	})
+
+	t.Run("close without iteration", func(t *testing.T) {
+		var closed bool
+		source := itertools.NewWithClose(func() (int, bool) {
+			return 0, false
+		}, func() { closed = true })
+
+		itertools.Prefetch(source, 4).Close()
+
+		if !closed {
+			t.Errorf("expected source to be closed")
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		iter := itertools.PrefetchContext(ctx, itertools.Repeat(1), 2)
+
+		if !iter.Next() {
+			t.Fatalf("expected element before cancel")
+		}
+		cancel()
+
+		if iter.Next() {
+			t.Errorf("expected no elements after cancel")
+		}
+		iter.Close()
+	})
+
+	t.Run("panic propagation", func(t *testing.T) {
+		var count int
+		iter := itertools.Prefetch(itertools.New(func() (int, bool) {
+			count++
+			if count > 2 {
+				panic("source failure")
+			}
+			return count, true
+		}), 1)
+
+		defer func() {
+			if r := recover(); r != "source failure" {
+				t.Errorf("expected panic %q, got %v", "source failure", r)
+			}
+		}()
+		iter.Collect()
+		t.Errorf("expected panic")
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("all elements", func(t *testing.T) {
+		var closed bool
+		data := []int{1, 2, 3}
+		var idx int
+		source := itertools.NewWithClose(func() (int, bool) {
+			if idx >= len(data) {
+				return 0, false
+			}
+			idx++
+			return data[idx-1], true
+		}, func() { closed = true })
+
+		ch := itertools.ToChan(context.Background(), source, 1)
+
+		result := itertools.NewChanIterator(ch).Collect()
+		if !sliceEqual(data, result) {
+			t.Errorf("expected %v, got %v", data, result)
+		}
+		if !closed {
+			t.Errorf("expected source to be closed")
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := itertools.ToChan(ctx, itertools.Repeat(1), 0)
+
+		if v := <-ch; v != 1 {
+			t.Errorf("expected 1, got %d", v)
+		}
+		cancel()
+
+		for range ch {
+		}
+	})
+}
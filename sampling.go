@@ -0,0 +1,170 @@
+package itertools
+
+import (
+	"container/heap"
+	"math"
+	"math/rand/v2"
+)
+
+// ReservoirSample returns uniform random sample of k elements of iterator,
+// consuming all its elements. If iterator has fewer than k elements, all of them are returned.
+// Order of the sampled elements is unspecified.
+// ReservoirSample uses "Algorithm L", which skips most of the elements without
+// generating random numbers for them, and requires space complexity of O(k).
+// If k is non-positive, ReservoirSample returns nil.
+// If rng is nil, randomly seeded generator is used.
+func ReservoirSample[T any](i *Iterator[T], k int, rng *rand.Rand) []T {
+	if k <= 0 {
+		return nil
+	}
+	rng = rngOrDefault(rng)
+
+	reservoir := make([]T, 0, k)
+	for len(reservoir) < k && i.Next() {
+		reservoir = append(reservoir, i.Elem())
+	}
+	if len(reservoir) < k {
+		return reservoir
+	}
+
+	w := math.Exp(math.Log(positiveFloat(rng)) / float64(k))
+	for {
+		skip := math.Floor(math.Log(positiveFloat(rng)) / math.Log1p(-w))
+		n := math.MaxInt
+		if skip < float64(math.MaxInt) {
+			n = int(skip)
+		}
+		if i.Drop(n) < n || !i.Next() {
+			return reservoir
+		}
+		reservoir[rng.IntN(k)] = i.Elem()
+		w *= math.Exp(math.Log(positiveFloat(rng)) / float64(k))
+	}
+}
+
+// WeightedReservoirSample returns random sample of k elements of iterator without replacement,
+// where probability of element to be sampled is proportional to its weight.
+// Elements with non-positive weight are never sampled.
+// If iterator has fewer than k elements with positive weight, all of them are returned.
+// Order of the sampled elements is unspecified.
+// WeightedReservoirSample uses "Algorithm A-Res" and requires space complexity of O(k).
+// If k is non-positive, WeightedReservoirSample returns nil.
+// If rng is nil, randomly seeded generator is used.
+func WeightedReservoirSample[T any](i *Iterator[T], k int, weight func(T) float64, rng *rand.Rand) []T {
+	if k <= 0 {
+		return nil
+	}
+	rng = rngOrDefault(rng)
+
+	reservoir := &keyedHeap[T]{}
+	for i.Next() {
+		v := i.Elem()
+		w := weight(v)
+		if !(w > 0) {
+			continue
+		}
+		// log(u^(1/w)) is used as a key instead of u^(1/w) to avoid underflow for small weights
+		key := math.Log(positiveFloat(rng)) / w
+		if reservoir.Len() < k {
+			heap.Push(reservoir, Pair[float64, T]{First: key, Second: v})
+		} else if key > reservoir.elems[0].First {
+			reservoir.elems[0] = Pair[float64, T]{First: key, Second: v}
+			heap.Fix(reservoir, 0)
+		}
+	}
+
+	sample := make([]T, 0, reservoir.Len())
+	for _, elem := range reservoir.elems {
+		sample = append(sample, elem.Second)
+	}
+	return sample
+}
+
+// Bernoulli creates new iterator that yields every element of source iterator
+// with probability p independently of other elements.
+// If rng is nil, randomly seeded generator is used.
+func Bernoulli[T any](i *Iterator[T], p float64, rng *rand.Rand) *Iterator[T] {
+	rng = rngOrDefault(rng)
+	return i.Filter(func(T) bool {
+		return rng.Float64() < p
+	})
+}
+
+// Shuffled creates new iterator that yields elements of source iterator in random order.
+// Shuffled is lazy: elements of source iterator are collected on the first access
+// to the elements of returned iterator, and every next element is chosen randomly
+// from the remaining ones, so taking only few elements is cheap.
+// Shuffled requires space complexity of O(n).
+// If rng is nil, randomly seeded generator is used.
+func Shuffled[T any](i *Iterator[T], rng *rand.Rand, opts ...AllocationOption) *Iterator[T] {
+	rng = rngOrDefault(rng)
+	var (
+		values    []T
+		collected bool
+		pos       int
+		zero      T
+	)
+	return New(func() (T, bool) {
+		if !collected {
+			values = i.Collect(opts...)
+			collected = true
+		}
+		if pos >= len(values) {
+			return zero, false
+		}
+		j := pos + rng.IntN(len(values)-pos)
+		values[pos], values[j] = values[j], values[pos]
+		v := values[pos]
+		pos++
+		return v, true
+	}).onClose(i.Close).withHint(func() (int, int) {
+		if !collected {
+			return i.sizeHint()
+		}
+		remaining := len(values) - pos
+		return remaining, remaining
+	})
+}
+
+func rngOrDefault(rng *rand.Rand) *rand.Rand {
+	if rng != nil {
+		return rng
+	}
+	return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+}
+
+// positiveFloat returns random number in range (0, 1).
+func positiveFloat(rng *rand.Rand) float64 {
+	for {
+		if u := rng.Float64(); u > 0 {
+			return u
+		}
+	}
+}
+
+// keyedHeap is a min-heap of elements ordered by their keys.
+type keyedHeap[T any] struct {
+	elems []Pair[float64, T]
+}
+
+func (h *keyedHeap[T]) Len() int {
+	return len(h.elems)
+}
+
+func (h *keyedHeap[T]) Less(i, j int) bool {
+	return h.elems[i].First < h.elems[j].First
+}
+
+func (h *keyedHeap[T]) Swap(i, j int) {
+	h.elems[i], h.elems[j] = h.elems[j], h.elems[i]
+}
+
+func (h *keyedHeap[T]) Push(x any) {
+	h.elems = append(h.elems, x.(Pair[float64, T]))
+}
+
+func (h *keyedHeap[T]) Pop() any {
+	last := h.elems[len(h.elems)-1]
+	h.elems = h.elems[:len(h.elems)-1]
+	return last
+}
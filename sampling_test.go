@@ -0,0 +1,176 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSampling(t *testing.T) {
+	newRand := func() *rand.Rand {
+		return rand.New(rand.NewPCG(1, 2))
+	}
+
+	t.Run("reservoir sample", func(t *testing.T) {
+		result := itertools.ReservoirSample(itertools.Range(0, 1000, 1), 10, newRand())
+
+		if len(result) != 10 {
+			t.Fatalf("expected %d elements, got %v", 10, result)
+		}
+		slices.Sort(result)
+		if len(slices.Compact(slices.Clone(result))) != len(result) {
+			t.Errorf("expected distinct elements, got %v", result)
+		}
+		if result[0] < 0 || result[len(result)-1] >= 1000 {
+			t.Errorf("expected elements of source iterator, got %v", result)
+		}
+	})
+
+	t.Run("reproducible reservoir sample", func(t *testing.T) {
+		first := itertools.ReservoirSample(itertools.Range(0, 1000, 1), 5, newRand())
+		second := itertools.ReservoirSample(itertools.Range(0, 1000, 1), 5, newRand())
+
+		if !sliceEqual(first, second) {
+			t.Errorf("expected equal samples, got %v and %v", first, second)
+		}
+	})
+
+	t.Run("reservoir sample of short iterator", func(t *testing.T) {
+		result := itertools.ReservoirSample(itertools.NewSliceIterator([]int{1, 2, 3}), 5, newRand())
+
+		expected := []int{1, 2, 3}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zero size reservoir sample", func(t *testing.T) {
+		if result := itertools.ReservoirSample(itertools.Range(0, 10, 1), 0, newRand()); result != nil {
+			t.Errorf("expected nil, got %v", result)
+		}
+	})
+
+	t.Run("uniform reservoir sample", func(t *testing.T) {
+		const (
+			trials = 10000
+			n      = 10
+			k      = 3
+		)
+		rng := newRand()
+		counts := make([]int, n)
+		for range trials {
+			for _, v := range itertools.ReservoirSample(itertools.Range(0, n, 1), k, rng) {
+				counts[v]++
+			}
+		}
+
+		expected := trials * k / n
+		for v, count := range counts {
+			if count < expected*9/10 || count > expected*11/10 {
+				t.Errorf("expected element %d to be sampled about %d times, got %d", v, expected, count)
+			}
+		}
+	})
+
+	t.Run("weighted reservoir sample", func(t *testing.T) {
+		const trials = 10000
+		rng := newRand()
+		weights := map[string]float64{"light": 1, "heavy": 9, "weightless": 0}
+		var heavyCount int
+		for range trials {
+			result := itertools.WeightedReservoirSample(
+				itertools.NewSliceIterator([]string{"light", "heavy", "weightless"}),
+				1,
+				func(s string) float64 { return weights[s] },
+				rng,
+			)
+			if len(result) != 1 {
+				t.Fatalf("expected single element, got %v", result)
+			}
+			switch result[0] {
+			case "heavy":
+				heavyCount++
+			case "weightless":
+				t.Fatalf("did not expect element with zero weight to be sampled")
+			}
+		}
+
+		if heavyCount < trials*85/100 || heavyCount > trials*95/100 {
+			t.Errorf("expected heavy element to be sampled about %d times, got %d", trials*9/10, heavyCount)
+		}
+	})
+
+	t.Run("weighted reservoir sample of short iterator", func(t *testing.T) {
+		result := itertools.WeightedReservoirSample(
+			itertools.NewSliceIterator([]int{1, 2, 3}),
+			5,
+			func(n int) float64 { return float64(n) },
+			newRand(),
+		)
+		slices.Sort(result)
+
+		expected := []int{1, 2, 3}
+
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("bernoulli", func(t *testing.T) {
+		const n = 10000
+		result := itertools.Bernoulli(itertools.Range(0, n, 1), 0.3, newRand()).Count()
+
+		if result < n*28/100 || result > n*32/100 {
+			t.Errorf("expected about %d elements, got %d", n*3/10, result)
+		}
+	})
+
+	t.Run("bernoulli bounds", func(t *testing.T) {
+		if result := itertools.Bernoulli(itertools.Range(0, 100, 1), 0, newRand()).Count(); result != 0 {
+			t.Errorf("expected %d elements, got %d", 0, result)
+		}
+		if result := itertools.Bernoulli(itertools.Range(0, 100, 1), 1, newRand()).Count(); result != 100 {
+			t.Errorf("expected %d elements, got %d", 100, result)
+		}
+	})
+
+	t.Run("shuffled", func(t *testing.T) {
+		source := itertools.Range(0, 100, 1).Collect()
+
+		result := itertools.Shuffled(itertools.NewSliceIterator(source), newRand()).Collect()
+
+		if sliceEqual(source, result) {
+			t.Errorf("expected elements to be shuffled")
+		}
+		slices.Sort(result)
+		if !sliceEqual(source, result) {
+			t.Errorf("expected permutation of %v, got %v", source, result)
+		}
+	})
+
+	t.Run("reproducible shuffled", func(t *testing.T) {
+		first := itertools.Shuffled(itertools.Range(0, 100, 1), newRand()).Collect()
+		second := itertools.Shuffled(itertools.Range(0, 100, 1), newRand()).Collect()
+
+		if !sliceEqual(first, second) {
+			t.Errorf("expected equal permutations, got %v and %v", first, second)
+		}
+	})
+
+	t.Run("lazy shuffled", func(t *testing.T) {
+		source := itertools.NewSliceIterator([]int{1, 2, 3})
+		i := itertools.Shuffled(source, newRand())
+
+		if lower, _ := source.SizeHint(); lower != 3 {
+			t.Errorf("expected source iterator to be untouched")
+		}
+		if lower, upper := i.SizeHint(); lower != 3 || upper != 3 {
+			t.Errorf("expected (3, 3), got (%d, %d)", lower, upper)
+		}
+		if result := i.Count(); result != 3 {
+			t.Errorf("expected %d, got %d", 3, result)
+		}
+	})
+}
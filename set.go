@@ -0,0 +1,215 @@
+package itertools
+
+// Union creates new iterator that yields unique elements of both iterators:
+// first unique elements of a, then unique elements of b not met in a.
+// Union is lazy and requires space complexity of O(n) to track met elements.
+func Union[T comparable](a, b *Iterator[T], opts ...AllocationOption) *Iterator[T] {
+	return UnionFunc(a, b, identity[T], opts...)
+}
+
+// UnionFunc works like Union (see Union), but uniqueness of elements is defined
+// by return value of key.
+func UnionFunc[T any, K comparable](a, b *Iterator[T], key func(T) K, opts ...AllocationOption) *Iterator[T] {
+	var (
+		options allocOptions
+		zero    T
+	)
+	for _, opt := range opts {
+		opt(&options)
+	}
+	metKeys := make(map[K]struct{}, a.preallocSize(options))
+	source := a
+	return New(func() (T, bool) {
+		for {
+			v, ok := source.f()
+			if !ok {
+				if source == b {
+					return zero, false
+				}
+				source = b
+				continue
+			}
+			k := key(v)
+			if _, met := metKeys[k]; !met {
+				metKeys[k] = struct{}{}
+				return v, true
+			}
+		}
+	}).onClose(a.Close, b.Close)
+}
+
+// Intersection creates new iterator that yields unique elements of a
+// which are also present in b, in order of a.
+// Elements of b are collected into hash set on the first access to the elements
+// of returned iterator. Preallocation size (see WithPrealloc) is used for the hash set.
+func Intersection[T comparable](a, b *Iterator[T], opts ...AllocationOption) *Iterator[T] {
+	return IntersectionFunc(a, b, identity[T], opts...)
+}
+
+// IntersectionFunc works like Intersection (see Intersection), but elements are compared
+// by return value of key.
+func IntersectionFunc[T any, K comparable](a, b *Iterator[T], key func(T) K, opts ...AllocationOption) *Iterator[T] {
+	return filterBySet(a, b, key, true, opts)
+}
+
+// Difference creates new iterator that yields unique elements of a
+// which are not present in b, in order of a.
+// Elements of b are collected into hash set on the first access to the elements
+// of returned iterator. Preallocation size (see WithPrealloc) is used for the hash set.
+func Difference[T comparable](a, b *Iterator[T], opts ...AllocationOption) *Iterator[T] {
+	return DifferenceFunc(a, b, identity[T], opts...)
+}
+
+// DifferenceFunc works like Difference (see Difference), but elements are compared
+// by return value of key.
+func DifferenceFunc[T any, K comparable](a, b *Iterator[T], key func(T) K, opts ...AllocationOption) *Iterator[T] {
+	return filterBySet(a, b, key, false, opts)
+}
+
+// SymmetricDifference creates new iterator that yields unique elements present
+// in exactly one of iterators: first elements of a, then elements of b.
+// Elements of b are collected on the first access to the elements
+// of returned iterator. Preallocation size (see WithPrealloc) is used for the collected elements.
+func SymmetricDifference[T comparable](a, b *Iterator[T], opts ...AllocationOption) *Iterator[T] {
+	return SymmetricDifferenceFunc(a, b, identity[T], opts...)
+}
+
+// SymmetricDifferenceFunc works like SymmetricDifference (see SymmetricDifference),
+// but elements are compared by return value of key.
+func SymmetricDifferenceFunc[T any, K comparable](
+	a, b *Iterator[T],
+	key func(T) K,
+	opts ...AllocationOption,
+) *Iterator[T] {
+	var (
+		options allocOptions
+		zero    T
+	)
+	for _, opt := range opts {
+		opt(&options)
+	}
+	var (
+		// bKeys maps keys of b to flag showing if the key is also present in a.
+		bKeys   map[K]bool
+		bValues []T
+		aKeys   = make(map[K]struct{})
+		aDone   bool
+		idx     int
+	)
+	return New(func() (T, bool) {
+		if bKeys == nil {
+			bKeys = make(map[K]bool, b.preallocSize(options))
+			for v, ok := b.f(); ok; v, ok = b.f() {
+				k := key(v)
+				if _, met := bKeys[k]; !met {
+					bKeys[k] = false
+					bValues = append(bValues, v)
+				}
+			}
+		}
+		for !aDone {
+			v, ok := a.f()
+			if !ok {
+				aDone = true
+				break
+			}
+			k := key(v)
+			if _, met := aKeys[k]; met {
+				continue
+			}
+			aKeys[k] = struct{}{}
+			if _, shared := bKeys[k]; shared {
+				bKeys[k] = true
+				continue
+			}
+			return v, true
+		}
+		for idx < len(bValues) {
+			v := bValues[idx]
+			idx++
+			if !bKeys[key(v)] {
+				return v, true
+			}
+		}
+		return zero, false
+	}).onClose(a.Close, b.Close)
+}
+
+// IsSubset returns true if every element of a is present in b.
+// IsSubset is lazy and will stop iterating over a after first element missing in b.
+// Empty iterator is a subset of any iterator.
+func IsSubset[T comparable](a, b *Iterator[T], opts ...AllocationOption) bool {
+	return IsSubsetFunc(a, b, identity[T], opts...)
+}
+
+// IsSubsetFunc works like IsSubset (see IsSubset), but elements are compared
+// by return value of key.
+func IsSubsetFunc[T any, K comparable](a, b *Iterator[T], key func(T) K, opts ...AllocationOption) bool {
+	return !DifferenceFunc(a, b, key, opts...).Next()
+}
+
+// IsDisjoint returns true if a and b have no common elements.
+// IsDisjoint is lazy and will stop iterating over a after first element present in b.
+func IsDisjoint[T comparable](a, b *Iterator[T], opts ...AllocationOption) bool {
+	return IsDisjointFunc(a, b, identity[T], opts...)
+}
+
+// IsDisjointFunc works like IsDisjoint (see IsDisjoint), but elements are compared
+// by return value of key.
+func IsDisjointFunc[T any, K comparable](a, b *Iterator[T], key func(T) K, opts ...AllocationOption) bool {
+	return !IntersectionFunc(a, b, key, opts...).Next()
+}
+
+// CollectSet returns all elements of iterator as set.
+func CollectSet[T comparable](i *Iterator[T], opts ...AllocationOption) map[T]struct{} {
+	var options allocOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	set := make(map[T]struct{}, i.preallocSize(options))
+	for i.Next() {
+		set[i.Elem()] = struct{}{}
+	}
+	return set
+}
+
+// filterBySet creates iterator yielding unique elements of a which are present in b
+// if keepPresent is true, or absent otherwise.
+func filterBySet[T any, K comparable](
+	a, b *Iterator[T],
+	key func(T) K,
+	keepPresent bool,
+	opts []AllocationOption,
+) *Iterator[T] {
+	var options allocOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	var (
+		bKeys   map[K]struct{}
+		metKeys = make(map[K]struct{})
+		zero    T
+	)
+	return New(func() (T, bool) {
+		if bKeys == nil {
+			bKeys = make(map[K]struct{}, b.preallocSize(options))
+			for v, ok := b.f(); ok; v, ok = b.f() {
+				bKeys[key(v)] = struct{}{}
+			}
+		}
+		for v, ok := a.f(); ok; v, ok = a.f() {
+			k := key(v)
+			if _, met := metKeys[k]; met {
+				continue
+			}
+			metKeys[k] = struct{}{}
+			if _, present := bKeys[k]; present == keepPresent {
+				return v, true
+			}
+		}
+		return zero, false
+	}).onClose(a.Close, b.Close).withHint(func() (int, int) {
+		_, upper := a.sizeHint()
+		return 0, upper
+	})
+}
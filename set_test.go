@@ -0,0 +1,130 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"strings"
+	"testing"
+)
+
+func TestSetAlgebra(t *testing.T) {
+	a := []int{1, 2, 2, 3, 4, 1}
+	b := []int{3, 5, 4, 6, 5}
+
+	tcases := []struct {
+		name     string
+		op       func(a, b *itertools.Iterator[int]) *itertools.Iterator[int]
+		expected []int
+	}{
+		{
+			name:     "union",
+			op:       func(a, b *itertools.Iterator[int]) *itertools.Iterator[int] { return itertools.Union(a, b) },
+			expected: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "intersection",
+			op:       func(a, b *itertools.Iterator[int]) *itertools.Iterator[int] { return itertools.Intersection(a, b) },
+			expected: []int{3, 4},
+		},
+		{
+			name:     "difference",
+			op:       func(a, b *itertools.Iterator[int]) *itertools.Iterator[int] { return itertools.Difference(a, b) },
+			expected: []int{1, 2},
+		},
+		{
+			name: "symmetric difference",
+			op: func(a, b *itertools.Iterator[int]) *itertools.Iterator[int] {
+				return itertools.SymmetricDifference(a, b)
+			},
+			expected: []int{1, 2, 5, 6},
+		},
+	}
+
+	for _, tc := range tcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.op(itertools.NewSliceIterator(a), itertools.NewSliceIterator(b)).Collect()
+
+			if !sliceEqual(tc.expected, result) {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("func variants", func(t *testing.T) {
+		words := func(s ...string) *itertools.Iterator[string] { return itertools.NewSliceIterator(s) }
+
+		union := itertools.UnionFunc(words("a", "B"), words("b", "C"), strings.ToLower).Collect()
+		if expected := []string{"a", "B", "C"}; !sliceEqual(expected, union) {
+			t.Errorf("union: expected %v, got %v", expected, union)
+		}
+
+		intersection := itertools.IntersectionFunc(words("a", "B"), words("b", "C"), strings.ToLower).Collect()
+		if expected := []string{"B"}; !sliceEqual(expected, intersection) {
+			t.Errorf("intersection: expected %v, got %v", expected, intersection)
+		}
+
+		difference := itertools.DifferenceFunc(words("a", "B"), words("b", "C"), strings.ToLower).Collect()
+		if expected := []string{"a"}; !sliceEqual(expected, difference) {
+			t.Errorf("difference: expected %v, got %v", expected, difference)
+		}
+
+		symDiff := itertools.SymmetricDifferenceFunc(words("a", "B"), words("b", "C"), strings.ToLower).Collect()
+		if expected := []string{"a", "C"}; !sliceEqual(expected, symDiff) {
+			t.Errorf("symmetric difference: expected %v, got %v", expected, symDiff)
+		}
+	})
+
+	t.Run("symmetric difference does not call exhausted source", func(t *testing.T) {
+		// strict panics if it is called after the iteration is over
+		strict := func(s []int) *itertools.Iterator[int] {
+			var idx int
+			return itertools.New(func() (int, bool) {
+				if idx > len(s) {
+					panic("source called after the iteration is over")
+				}
+				idx++
+				if idx > len(s) {
+					return 0, false
+				}
+				return s[idx-1], true
+			})
+		}
+
+		result := itertools.SymmetricDifference(strict([]int{1, 2}), itertools.NewSliceIterator([]int{2, 3, 4})).Collect()
+
+		if expected := []int{1, 3, 4}; !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("subset and disjoint", func(t *testing.T) {
+		if !itertools.IsSubset(itertools.NewSliceIterator([]int{3, 4, 3}), itertools.NewSliceIterator(b)) {
+			t.Errorf("expected [3 4 3] to be subset of %v", b)
+		}
+		if itertools.IsSubset(itertools.NewSliceIterator(a), itertools.NewSliceIterator(b)) {
+			t.Errorf("expected %v not to be subset of %v", a, b)
+		}
+		if !itertools.IsSubset(itertools.NewSliceIterator([]int{}), itertools.NewSliceIterator([]int{})) {
+			t.Errorf("expected empty iterator to be subset of empty iterator")
+		}
+		if !itertools.IsDisjoint(itertools.NewSliceIterator([]int{1, 2}), itertools.NewSliceIterator(b)) {
+			t.Errorf("expected [1 2] to be disjoint with %v", b)
+		}
+		if itertools.IsDisjoint(itertools.NewSliceIterator(a), itertools.NewSliceIterator(b)) {
+			t.Errorf("expected %v not to be disjoint with %v", a, b)
+		}
+	})
+
+	t.Run("collect set", func(t *testing.T) {
+		set := itertools.CollectSet(itertools.NewSliceIterator(a))
+
+		if len(set) != 4 {
+			t.Errorf("expected 4 elements, got %d", len(set))
+		}
+		for _, v := range a {
+			if _, ok := set[v]; !ok {
+				t.Errorf("expected %d to be in set", v)
+			}
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package itertools
+
+import "math"
+
+// preallocSize returns capacity for buffer to collect elements of iterator into.
+// Explicitly set preallocation size has priority over the size hint.
+func (i *Iterator[T]) preallocSize(options allocOptions) int {
+	if options.preallocSize > 0 {
+		return options.preallocSize
+	}
+	if lower, upper := i.SizeHint(); upper >= 0 {
+		return lower
+	}
+	return 0
+}
+
+// hasExactSize reports if size hint of iterator provides exact amount of remaining elements.
+func hasExactSize[T any](i *Iterator[T]) bool {
+	lower, upper := i.sizeHint()
+	return lower == upper
+}
+
+// sumHints returns bounds on the total amount of elements in iterators with given size hints.
+func sumHints(lower1, upper1, lower2, upper2 int) (lower, upper int) {
+	lower = saturatingAdd(lower1, lower2)
+	if upper1 < 0 || upper2 < 0 || upper1 > math.MaxInt-upper2 {
+		return lower, -1
+	}
+	return lower, upper1 + upper2
+}
+
+// minHints returns bounds on the amount of elements in iterator
+// that ends when either of iterators with given size hints ends.
+func minHints(lower1, upper1, lower2, upper2 int) (lower, upper int) {
+	lower = min(lower1, lower2)
+	switch {
+	case upper1 < 0:
+		upper = upper2
+	case upper2 < 0:
+		upper = upper1
+	default:
+		upper = min(upper1, upper2)
+	}
+	return lower, upper
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
@@ -0,0 +1,213 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math"
+	"testing"
+)
+
+func TestSizeHint(t *testing.T) {
+	type hint struct {
+		lower, upper int
+	}
+
+	testcases := []struct {
+		name     string
+		hint     func() (int, int)
+		expected hint
+	}{
+		{
+			name:     "new",
+			hint:     itertools.New(fibonacciYielder(100)).SizeHint,
+			expected: hint{0, -1},
+		},
+		{
+			name:     "slice",
+			hint:     itertools.NewSliceIterator([]int{1, 2, 3}).SizeHint,
+			expected: hint{3, 3},
+		},
+		{
+			name:     "ascii",
+			hint:     itertools.NewAsciiIterator("hello").SizeHint,
+			expected: hint{5, 5},
+		},
+		{
+			name:     "utf8",
+			hint:     itertools.NewUTF8Iterator("привет").SizeHint,
+			expected: hint{3, 12},
+		},
+		{
+			name:     "utf8 with invalid rune",
+			hint:     itertools.NewUTF8Iterator("a\xffbcdefgh").SizeHint,
+			expected: hint{1, 1},
+		},
+		{
+			name:     "map",
+			hint:     itertools.NewMapIterator(map[int]string{1: "a", 2: "b"}).SizeHint,
+			expected: hint{2, 2},
+		},
+		{
+			name:     "repeat",
+			hint:     itertools.Repeat(1).SizeHint,
+			expected: hint{math.MaxInt, -1},
+		},
+		{
+			name: "map func",
+			hint: itertools.Map(
+				itertools.NewSliceIterator([]int{1, 2, 3}),
+				func(n int) int { return n * 2 },
+			).SizeHint,
+			expected: hint{3, 3},
+		},
+		{
+			name: "filter",
+			hint: itertools.NewSliceIterator([]int{1, 2, 3}).Filter(func(n int) bool {
+				return n > 1
+			}).SizeHint,
+			expected: hint{0, 3},
+		},
+		{
+			name:     "limit",
+			hint:     itertools.NewSliceIterator([]int{1, 2, 3}).Limit(2).SizeHint,
+			expected: hint{2, 2},
+		},
+		{
+			name:     "limit of infinite",
+			hint:     itertools.Repeat(1).Limit(5).SizeHint,
+			expected: hint{5, 5},
+		},
+		{
+			name:     "limit of unknown",
+			hint:     itertools.New(fibonacciYielder(100)).Limit(5).SizeHint,
+			expected: hint{0, 5},
+		},
+		{
+			name: "zip",
+			hint: itertools.Zip(
+				itertools.NewSliceIterator([]int{1, 2, 3}),
+				itertools.Repeat("a"),
+			).SizeHint,
+			expected: hint{3, 3},
+		},
+		{
+			name: "chain",
+			hint: itertools.Chain(
+				itertools.NewSliceIterator([]int{1, 2, 3}),
+				itertools.NewSliceIterator([]int{4, 5}),
+			).SizeHint,
+			expected: hint{5, 5},
+		},
+		{
+			name: "chain with unknown",
+			hint: itertools.Chain(
+				itertools.NewSliceIterator([]int{1, 2, 3}),
+				itertools.New(fibonacciYielder(100)),
+			).SizeHint,
+			expected: hint{3, -1},
+		},
+		{
+			name:     "batched",
+			hint:     itertools.Batched(itertools.NewSliceIterator([]int{1, 2, 3, 4, 5}), 2).SizeHint,
+			expected: hint{3, 3},
+		},
+		{
+			name:     "enumerate",
+			hint:     itertools.Enumerate(itertools.NewAsciiIterator("abc")).SizeHint,
+			expected: hint{3, 3},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			lower, upper := tc.hint()
+			if result := (hint{lower, upper}); result != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("hint after iteration", func(t *testing.T) {
+		i := itertools.Chain(
+			itertools.NewSliceIterator([]int{1, 2, 3}),
+			itertools.NewSliceIterator([]int{4, 5}),
+		)
+		i.Next()
+		i.Next()
+		i.Next()
+		i.Next()
+		if lower, upper := i.SizeHint(); lower != 1 || upper != 1 {
+			t.Errorf("expected (1, 1), got (%d, %d)", lower, upper)
+		}
+		i.Next()
+		i.Next()
+		if lower, upper := i.SizeHint(); lower != 0 || upper != 0 {
+			t.Errorf("expected (0, 0), got (%d, %d)", lower, upper)
+		}
+	})
+
+	t.Run("collect preallocation", func(t *testing.T) {
+		result := itertools.Map(
+			itertools.NewSliceIterator([]int{1, 2, 3, 4}),
+			func(n int) int { return n * n },
+		).Collect()
+
+		if cap(result) != 4 {
+			t.Errorf("expected capacity %d, got %d", 4, cap(result))
+		}
+	})
+}
+
+func TestFastPaths(t *testing.T) {
+	t.Run("slice count", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5})
+		i.Next()
+		if result := i.Count(); result != 4 {
+			t.Errorf("expected %d, got %d", 4, result)
+		}
+		if i.Next() {
+			t.Errorf("did not expect elements after Count; elem: %d", i.Elem())
+		}
+	})
+
+	t.Run("slice drop", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5})
+		if result := i.Drop(3); result != 3 {
+			t.Errorf("expected %d, got %d", 3, result)
+		}
+		result := i.Collect()
+		expected := []int{4, 5}
+		if !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if result := i.Drop(3); result != 0 {
+			t.Errorf("expected %d, got %d", 0, result)
+		}
+	})
+
+	t.Run("ascii drop", func(t *testing.T) {
+		i := itertools.NewAsciiIterator("hello")
+		if result := i.Drop(10); result != 5 {
+			t.Errorf("expected %d, got %d", 5, result)
+		}
+	})
+
+	t.Run("nth", func(t *testing.T) {
+		i := itertools.NewSliceIterator([]int{1, 2, 3, 4, 5})
+		if result, ok := i.Nth(1); !ok || result != 2 {
+			t.Errorf("expected %d, got %d", 2, result)
+		}
+		if result, ok := i.Nth(1); !ok || result != 4 {
+			t.Errorf("expected %d, got %d", 4, result)
+		}
+		if result, ok := i.Nth(1); ok {
+			t.Errorf("did not expect to find element; found %d", result)
+		}
+	})
+
+	t.Run("nth of generic iterator", func(t *testing.T) {
+		i := itertools.New(fibonacciYielder(100))
+		if result, ok := i.Nth(6); !ok || result != 8 {
+			t.Errorf("expected %d, got %d", 8, result)
+		}
+	})
+}
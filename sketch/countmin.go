@@ -0,0 +1,87 @@
+package sketch
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math"
+)
+
+// CountMin is a Count-Min sketch estimating frequencies of elements in fixed memory.
+// Estimated frequency is never less than the actual one and with probability 1-delta
+// exceeds it by no more than epsilon multiplied by total amount of added elements.
+type CountMin struct {
+	width  int
+	depth  int
+	counts []uint64
+	total  uint64
+}
+
+// NewCountMin creates new empty CountMin sketch with error bound epsilon
+// and failure probability delta.
+// Both epsilon and delta must be in range (0, 1), otherwise they are considered equal to 0.01.
+func NewCountMin(epsilon, delta float64) *CountMin {
+	const defaultBound = 0.01
+	if !(epsilon > 0 && epsilon < 1) {
+		epsilon = defaultBound
+	}
+	if !(delta > 0 && delta < 1) {
+		delta = defaultBound
+	}
+	width := int(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	return &CountMin{
+		width:  width,
+		depth:  depth,
+		counts: make([]uint64, width*depth),
+	}
+}
+
+// CountMinOf creates CountMin sketch with given error bounds
+// containing hashes of all elements of iterator.
+func CountMinOf[T any](i *itertools.Iterator[T], hash func(T) uint64, epsilon, delta float64) *CountMin {
+	cm := NewCountMin(epsilon, delta)
+	for i.Next() {
+		cm.Add(hash(i.Elem()), 1)
+	}
+	return cm
+}
+
+// Add adds count occurrences of element with given hash to the sketch.
+func (cm *CountMin) Add(hash uint64, count uint64) {
+	cm.total += count
+	for row := 0; row < cm.depth; row++ {
+		cm.counts[cm.index(hash, row)] += count
+	}
+}
+
+// Estimate returns estimated frequency of element with given hash.
+func (cm *CountMin) Estimate(hash uint64) uint64 {
+	estimate := uint64(math.MaxUint64)
+	for row := 0; row < cm.depth; row++ {
+		estimate = min(estimate, cm.counts[cm.index(hash, row)])
+	}
+	return estimate
+}
+
+// Total returns total amount of added elements.
+func (cm *CountMin) Total() uint64 {
+	return cm.total
+}
+
+// Merge adds elements of other sketch to cm.
+// Sketches must have the same error bounds, otherwise Merge returns false
+// and does not change cm.
+func (cm *CountMin) Merge(other *CountMin) bool {
+	if cm.width != other.width || cm.depth != other.depth {
+		return false
+	}
+	for idx, count := range other.counts {
+		cm.counts[idx] += count
+	}
+	cm.total += other.total
+	return true
+}
+
+func (cm *CountMin) index(hash uint64, row int) int {
+	h1, h2 := mix64(hash), mix64(^hash)|1
+	return row*cm.width + int((h1+uint64(row)*h2)%uint64(cm.width))
+}
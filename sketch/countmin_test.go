@@ -0,0 +1,54 @@
+package sketch_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/sketch"
+	"testing"
+)
+
+func TestCountMin(t *testing.T) {
+	hash := func(n int) uint64 { return uint64(n) }
+	// element k occurs k times
+	source := func(from, to int) *itertools.Iterator[int] {
+		return itertools.Generate(func(yield func(int) bool) {
+			for k := from; k < to; k++ {
+				for range k {
+					if !yield(k) {
+						return
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("estimate", func(t *testing.T) {
+		const epsilon = 0.001
+		cm := sketch.CountMinOf(source(0, 200), hash, epsilon, 0.01)
+
+		if expected := uint64(199 * 200 / 2); cm.Total() != expected {
+			t.Errorf("expected total %d, got %d", expected, cm.Total())
+		}
+		maxError := uint64(epsilon * float64(cm.Total()))
+		for k := 0; k < 200; k++ {
+			estimate := cm.Estimate(hash(k))
+			if estimate < uint64(k) || estimate > uint64(k)+maxError {
+				t.Errorf("expected estimate for %d in range [%d, %d], got %d", k, k, uint64(k)+maxError, estimate)
+			}
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		cm1 := sketch.CountMinOf(source(0, 100), hash, 0.001, 0.01)
+		cm2 := sketch.CountMinOf(source(100, 200), hash, 0.001, 0.01)
+
+		if !cm1.Merge(cm2) {
+			t.Fatalf("expected sketches to be merged")
+		}
+		if estimate := cm1.Estimate(hash(150)); estimate < 150 {
+			t.Errorf("expected estimate at least %d, got %d", 150, estimate)
+		}
+		if cm1.Merge(sketch.NewCountMin(0.1, 0.1)) {
+			t.Errorf("did not expect sketches with different bounds to be merged")
+		}
+	})
+}
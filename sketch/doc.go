@@ -0,0 +1,5 @@
+// Package sketch provides probabilistic data structures (sketches)
+// estimating cardinality and frequencies of iterator elements in fixed memory.
+// All sketches are mergeable, so sketches built for parts of data
+// (e.g. in parallel) can be combined into a single one.
+package sketch
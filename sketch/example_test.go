@@ -0,0 +1,43 @@
+package sketch_test
+
+import (
+	"fmt"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/sketch"
+	"hash/maphash"
+	"strings"
+)
+
+func ExampleCountDistinctApprox() {
+	users := make(chan string)
+	go func() {
+		for k := 0; k < 3000; k++ {
+			users <- fmt.Sprintf("user-%d", k%1000)
+		}
+		close(users)
+	}()
+
+	seed := maphash.MakeSeed()
+	count := sketch.CountDistinctApprox(
+		itertools.NewChanIterator(users),
+		func(user string) uint64 { return maphash.String(seed, user) },
+		sketch.DefaultPrecision,
+	)
+
+	fmt.Println("about 1000 distinct users:", count > 950 && count < 1050)
+	// Output:
+	// about 1000 distinct users: true
+}
+
+func ExampleTopFrequent() {
+	words := strings.Fields("the quick brown fox jumps over the lazy dog the fox")
+
+	top := sketch.TopFrequent(itertools.NewSliceIterator(words), 2, 10)
+
+	for _, f := range top {
+		fmt.Println(f.Value, f.Count)
+	}
+	// Output:
+	// the 3
+	// fox 2
+}
@@ -0,0 +1,12 @@
+package sketch
+
+// mix64 is a finalizer of SplitMix64 generator, used to improve
+// distribution of provided hashes and to derive independent hashes.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
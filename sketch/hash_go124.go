@@ -0,0 +1,16 @@
+//go:build go1.24
+
+package sketch
+
+import "hash/maphash"
+
+// NewComparableHasher creates hash function for comparable values using hash/maphash
+// with random seed. Sketches can be merged only if they were built
+// with the same hash function, so the same hasher must be shared between them.
+// NewComparableHasher requires Go 1.24 or newer.
+func NewComparableHasher[T comparable]() func(T) uint64 {
+	seed := maphash.MakeSeed()
+	return func(v T) uint64 {
+		return maphash.Comparable(seed, v)
+	}
+}
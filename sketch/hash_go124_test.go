@@ -0,0 +1,30 @@
+//go:build go1.24
+
+package sketch_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/sketch"
+	"testing"
+)
+
+func TestComparableHasher(t *testing.T) {
+	type key struct {
+		name string
+		id   int
+	}
+	hash := sketch.NewComparableHasher[key]()
+
+	if hash(key{"a", 1}) != hash(key{"a", 1}) {
+		t.Errorf("expected equal values to have equal hashes")
+	}
+
+	result := sketch.CountDistinctApprox(
+		itertools.Map(itertools.Range(0, 3000, 1), func(n int) key { return key{"k", n % 1000} }),
+		hash,
+		sketch.DefaultPrecision,
+	)
+	if result < 950 || result > 1050 {
+		t.Errorf("expected estimate close to %d, got %d", 1000, result)
+	}
+}
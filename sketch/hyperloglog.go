@@ -0,0 +1,113 @@
+package sketch
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// MinPrecision is the minimal precision of HyperLogLog.
+	MinPrecision = 4
+	// MaxPrecision is the maximal precision of HyperLogLog.
+	MaxPrecision = 18
+	// DefaultPrecision is the default precision of HyperLogLog.
+	// It provides standard error about 0.8% using 16 KiB of memory.
+	DefaultPrecision = 14
+)
+
+// HyperLogLog estimates amount of distinct elements in fixed memory.
+// HyperLogLog with precision p uses 2^p bytes and has standard error about 1.04/sqrt(2^p).
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+// NewHyperLogLog creates new empty HyperLogLog with given precision.
+// Precision is clamped to range [MinPrecision, MaxPrecision].
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	precision = min(max(precision, MinPrecision), MaxPrecision)
+	return &HyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+// HyperLogLogOf creates HyperLogLog with given precision containing
+// hashes of all elements of iterator.
+func HyperLogLogOf[T any](i *itertools.Iterator[T], hash func(T) uint64, precision uint8) *HyperLogLog {
+	hll := NewHyperLogLog(precision)
+	for i.Next() {
+		hll.Add(hash(i.Elem()))
+	}
+	return hll
+}
+
+// CountDistinctApprox returns estimated amount of distinct elements of iterator
+// using HyperLogLog with given precision. Elements are distinguished by their hashes.
+func CountDistinctApprox[T any](i *itertools.Iterator[T], hash func(T) uint64, precision uint8) int {
+	return HyperLogLogOf(i, hash, precision).Count()
+}
+
+// Add adds element with given hash to the sketch.
+func (h *HyperLogLog) Add(hash uint64) {
+	hash = mix64(hash)
+	idx := hash >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+	h.registers[idx] = max(h.registers[idx], rank)
+}
+
+// Merge adds elements of other sketch to h.
+// Sketches must have the same precision, otherwise Merge returns false
+// and does not change h.
+func (h *HyperLogLog) Merge(other *HyperLogLog) bool {
+	if h.precision != other.precision {
+		return false
+	}
+	for idx, rank := range other.registers {
+		h.registers[idx] = max(h.registers[idx], rank)
+	}
+	return true
+}
+
+// Clone returns copy of the sketch.
+func (h *HyperLogLog) Clone() *HyperLogLog {
+	return &HyperLogLog{
+		precision: h.precision,
+		registers: slices.Clone(h.registers),
+	}
+}
+
+// Count returns estimated amount of distinct added elements.
+func (h *HyperLogLog) Count() int {
+	m := float64(len(h.registers))
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, rank := range h.registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+	estimate := hllAlpha(len(h.registers)) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int(math.Round(estimate))
+}
+
+func hllAlpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
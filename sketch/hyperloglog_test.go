@@ -0,0 +1,59 @@
+package sketch_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/sketch"
+	"math"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	hash := func(n int) uint64 { return uint64(n) }
+
+	t.Run("count distinct", func(t *testing.T) {
+		for _, n := range []int{0, 10, 1000, 100000} {
+			source := itertools.Chain(itertools.Range(0, n, 1), itertools.Range(0, n, 1))
+
+			result := sketch.CountDistinctApprox(source, hash, sketch.DefaultPrecision)
+
+			if diff := math.Abs(float64(result - n)); diff > math.Max(0.03*float64(n), 2) {
+				t.Errorf("expected estimate close to %d, got %d", n, result)
+			}
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		hll1 := sketch.HyperLogLogOf(itertools.Range(0, 60000, 1), hash, sketch.DefaultPrecision)
+		hll2 := sketch.HyperLogLogOf(itertools.Range(40000, 100000, 1), hash, sketch.DefaultPrecision)
+
+		if !hll1.Merge(hll2) {
+			t.Fatalf("expected sketches to be merged")
+		}
+		if result := hll1.Count(); math.Abs(float64(result-100000))/100000 > 0.03 {
+			t.Errorf("expected estimate close to %d, got %d", 100000, result)
+		}
+	})
+
+	t.Run("merge with different precision", func(t *testing.T) {
+		hll := sketch.NewHyperLogLog(10)
+		if hll.Merge(sketch.NewHyperLogLog(12)) {
+			t.Errorf("did not expect sketches with different precision to be merged")
+		}
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		hll := sketch.HyperLogLogOf(itertools.Range(0, 100, 1), hash, sketch.DefaultPrecision)
+		expected := hll.Count()
+
+		clone := hll.Clone()
+		for k := 100; k < 1000; k++ {
+			clone.Add(hash(k))
+		}
+		if result := hll.Count(); result != expected {
+			t.Errorf("expected clone to be independent: estimate changed from %d to %d", expected, result)
+		}
+		if result := clone.Count(); math.Abs(float64(result-1000))/1000 > 0.05 {
+			t.Errorf("expected clone estimate close to %d, got %d", 1000, result)
+		}
+	})
+}
@@ -0,0 +1,215 @@
+package sketch
+
+import (
+	"cmp"
+	"container/heap"
+	"github.com/KSpaceer/itertools"
+	"math"
+	"slices"
+)
+
+// Frequency describes estimated frequency of value.
+// Actual frequency of the value is in range [Count-Error, Count].
+type Frequency[T any] struct {
+	Value T
+	Count int
+	Error int
+}
+
+// SpaceSaving tracks the most frequent elements in fixed memory
+// using Space-Saving algorithm. SpaceSaving with capacity k guarantees
+// that every element with frequency greater than N/k (where N is the total amount
+// of added elements) is tracked.
+//
+// Counters are kept in indexed min-heap by count, so adding an element takes O(log k) time.
+type SpaceSaving[T comparable] struct {
+	capacity int
+	counters map[T]*counter[T]
+	heap     counterHeap[T]
+	total    int
+}
+
+// counter is a Frequency with its index in counterHeap.
+type counter[T any] struct {
+	Frequency[T]
+	idx int
+}
+
+// counterHeap is a min-heap of counters by count.
+type counterHeap[T any] []*counter[T]
+
+func (h counterHeap[T]) Len() int {
+	return len(h)
+}
+
+func (h counterHeap[T]) Less(i, j int) bool {
+	return h[i].Count < h[j].Count
+}
+
+func (h counterHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].idx = i
+	h[j].idx = j
+}
+
+func (h *counterHeap[T]) Push(x any) {
+	c := x.(*counter[T])
+	c.idx = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *counterHeap[T]) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return c
+}
+
+// NewSpaceSaving creates new empty SpaceSaving tracking at most capacity elements.
+// If capacity is non-positive, it is considered to be 1.
+func NewSpaceSaving[T comparable](capacity int) *SpaceSaving[T] {
+	capacity = max(capacity, 1)
+	return &SpaceSaving[T]{
+		capacity: capacity,
+		counters: make(map[T]*counter[T], capacity),
+		heap:     make(counterHeap[T], 0, capacity),
+	}
+}
+
+// SpaceSavingOf creates SpaceSaving with given capacity containing all elements of iterator.
+func SpaceSavingOf[T comparable](i *itertools.Iterator[T], capacity int) *SpaceSaving[T] {
+	ss := NewSpaceSaving[T](capacity)
+	for i.Next() {
+		ss.Add(i.Elem())
+	}
+	return ss
+}
+
+// TopFrequent returns k most frequent elements of iterator in descending order of frequency,
+// tracking at most capacity candidates (see SpaceSaving).
+// If capacity is less than k, k candidates are tracked.
+func TopFrequent[T comparable](i *itertools.Iterator[T], k, capacity int) []Frequency[T] {
+	return SpaceSavingOf(i, max(k, capacity)).Top(k)
+}
+
+// HeavyHitters returns elements of iterator which frequency is probably greater
+// than phi multiplied by the amount of elements, in descending order of frequency.
+// Every such element is guaranteed to be returned, while returned elements
+// can include some less frequent ones. HeavyHitters uses O(1/phi) memory.
+// If phi is not in range (0, 1), HeavyHitters returns nil.
+func HeavyHitters[T comparable](i *itertools.Iterator[T], phi float64) []Frequency[T] {
+	if !(phi > 0 && phi < 1) {
+		return nil
+	}
+	ss := SpaceSavingOf(i, int(math.Ceil(1/phi)))
+	threshold := phi * float64(ss.Total())
+	return slices.DeleteFunc(ss.Top(ss.capacity), func(f Frequency[T]) bool {
+		return float64(f.Count) <= threshold
+	})
+}
+
+// Add adds element v to the sketch.
+func (ss *SpaceSaving[T]) Add(v T) {
+	ss.total++
+	if c, ok := ss.counters[v]; ok {
+		c.Count++
+		heap.Fix(&ss.heap, c.idx)
+		return
+	}
+	if len(ss.counters) < ss.capacity {
+		c := &counter[T]{Frequency: Frequency[T]{Value: v, Count: 1}}
+		ss.counters[v] = c
+		heap.Push(&ss.heap, c)
+		return
+	}
+	// the counter with minimal count is replaced by the new element
+	c := ss.heap[0]
+	delete(ss.counters, c.Value)
+	c.Value = v
+	c.Error = c.Count
+	c.Count++
+	ss.counters[v] = c
+	heap.Fix(&ss.heap, c.idx)
+}
+
+// minCount returns minimal count of tracked elements if the sketch is full or 0 otherwise.
+func (ss *SpaceSaving[T]) minCount() int {
+	if len(ss.counters) < ss.capacity {
+		return 0
+	}
+	return ss.heap[0].Count
+}
+
+// Total returns total amount of added elements.
+func (ss *SpaceSaving[T]) Total() int {
+	return ss.total
+}
+
+// Top returns at most k tracked elements with the greatest estimated frequencies
+// in descending order of frequency.
+func (ss *SpaceSaving[T]) Top(k int) []Frequency[T] {
+	if k <= 0 {
+		return nil
+	}
+	result := make([]Frequency[T], 0, len(ss.counters))
+	for _, c := range ss.counters {
+		result = append(result, c.Frequency)
+	}
+	slices.SortFunc(result, func(a, b Frequency[T]) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Error, b.Error)
+	})
+	return result[:min(k, len(result))]
+}
+
+// Merge adds elements of other sketch to ss.
+// Elements missing in one of the sketches are estimated with
+// the minimal count of the sketch if it is full.
+func (ss *SpaceSaving[T]) Merge(other *SpaceSaving[T]) {
+	ssMin, otherMin := ss.minCount(), other.minCount()
+
+	merged := make([]Frequency[T], 0, len(ss.counters)+len(other.counters))
+	for v, c := range ss.counters {
+		f := c.Frequency
+		if otherCounter, ok := other.counters[v]; ok {
+			f.Count += otherCounter.Count
+			f.Error += otherCounter.Error
+		} else {
+			f.Count += otherMin
+			f.Error += otherMin
+		}
+		merged = append(merged, f)
+	}
+	for v, c := range other.counters {
+		if _, ok := ss.counters[v]; ok {
+			continue
+		}
+		f := c.Frequency
+		f.Count += ssMin
+		f.Error += ssMin
+		merged = append(merged, f)
+	}
+
+	if len(merged) > ss.capacity {
+		slices.SortFunc(merged, func(a, b Frequency[T]) int {
+			if c := cmp.Compare(b.Count, a.Count); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.Error, b.Error)
+		})
+		merged = merged[:ss.capacity]
+	}
+
+	ss.total += other.total
+	ss.counters = make(map[T]*counter[T], ss.capacity)
+	ss.heap = make(counterHeap[T], len(merged), ss.capacity)
+	for idx, f := range merged {
+		c := &counter[T]{Frequency: f, idx: idx}
+		ss.counters[f.Value] = c
+		ss.heap[idx] = c
+	}
+	heap.Init(&ss.heap)
+}
@@ -0,0 +1,86 @@
+package sketch_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/sketch"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSpaceSaving(t *testing.T) {
+	// "a" occurs 50 times, "b" - 30 times, "c" - 15 times and 100 other elements once.
+	data := slices.Concat(
+		itertools.RepeatN("a", 50).Collect(),
+		itertools.RepeatN("b", 30).Collect(),
+		itertools.RepeatN("c", 15).Collect(),
+		itertools.Map(itertools.Range(0, 100, 1), func(k int) string {
+			return string(rune('A' + k))
+		}).Collect(),
+	)
+	rand.New(rand.NewSource(7)).Shuffle(len(data), func(i, j int) {
+		data[i], data[j] = data[j], data[i]
+	})
+
+	t.Run("top frequent", func(t *testing.T) {
+		result := sketch.TopFrequent(itertools.NewSliceIterator(data), 3, 20)
+
+		values := make([]string, 0, len(result))
+		for _, f := range result {
+			values = append(values, f.Value)
+		}
+		if expected := []string{"a", "b", "c"}; !slices.Equal(expected, values) {
+			t.Errorf("expected %v, got %v", expected, values)
+		}
+		for _, f := range result {
+			actual := countOf(data, f.Value)
+			if f.Count < actual || f.Count-f.Error > actual {
+				t.Errorf("expected actual count %d of %q to be in range [%d, %d]", actual, f.Value, f.Count-f.Error, f.Count)
+			}
+		}
+	})
+
+	t.Run("heavy hitters", func(t *testing.T) {
+		result := sketch.HeavyHitters(itertools.NewSliceIterator(data), 0.1)
+
+		values := make([]string, 0, len(result))
+		for _, f := range result {
+			values = append(values, f.Value)
+		}
+		for _, expected := range []string{"a", "b"} {
+			if !slices.Contains(values, expected) {
+				t.Errorf("expected %q to be heavy hitter, got %v", expected, values)
+			}
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		ss1 := sketch.SpaceSavingOf(itertools.NewSliceIterator(data[:len(data)/2]), 20)
+		ss2 := sketch.SpaceSavingOf(itertools.NewSliceIterator(data[len(data)/2:]), 20)
+		ss1.Merge(ss2)
+
+		if ss1.Total() != len(data) {
+			t.Errorf("expected total %d, got %d", len(data), ss1.Total())
+		}
+		result := ss1.Top(2)
+		if len(result) != 2 || result[0].Value != "a" || result[1].Value != "b" {
+			t.Errorf("expected a and b to be the most frequent, got %v", result)
+		}
+		for _, f := range result {
+			actual := countOf(data, f.Value)
+			if f.Count < actual || f.Count-f.Error > actual {
+				t.Errorf("expected actual count %d of %q to be in range [%d, %d]", actual, f.Value, f.Count-f.Error, f.Count)
+			}
+		}
+	})
+}
+
+func countOf[T comparable](s []T, v T) int {
+	var count int
+	for _, elem := range s {
+		if elem == v {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,3 @@
+// Package stats provides single-pass statistical aggregations
+// over iterators of numbers.
+package stats
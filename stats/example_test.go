@@ -0,0 +1,64 @@
+package stats_test
+
+import (
+	"fmt"
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/stats"
+)
+
+func ExampleStdDev() {
+	data := []int{6, 10, 7, 12, 6, 14, 8, 13, 10, 14}
+
+	fmt.Printf("standard deviation: %.2f", stats.StdDev(itertools.NewSliceIterator(data)))
+	// Output:
+	// standard deviation: 3.16
+}
+
+func ExampleMomentsOf() {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	m := stats.MomentsOf(itertools.NewSliceIterator(data))
+
+	fmt.Println("count:", m.Count())
+	fmt.Println("mean:", m.Mean())
+	fmt.Println("population variance:", m.PopulationVariance())
+	// Output:
+	// count: 8
+	// mean: 5
+	// population variance: 4
+}
+
+func ExampleQuantiles() {
+	data := []int{15, 20, 35, 40, 50}
+
+	fmt.Println(stats.Quantiles(itertools.NewSliceIterator(data), 0.25, 0.5, 0.75))
+	// Output:
+	// [20 35 40]
+}
+
+func ExampleTDigest() {
+	td := stats.TDigestOf(itertools.Range(0, 10001, 1), stats.DefaultCompression)
+
+	fmt.Printf("p50: %.0f\n", td.Quantile(0.5))
+	fmt.Printf("p99: %.0f\n", td.Quantile(0.99))
+	// Output:
+	// p50: 5000
+	// p99: 9900
+}
+
+func ExampleHistogramOf() {
+	latencies := []float64{12, 48, 95, 150, 210, 35, 870, 64}
+
+	h := stats.HistogramOf(itertools.NewSliceIterator(latencies), []float64{50, 100, 500})
+
+	buckets := h.Buckets()
+	for buckets.Next() {
+		b := buckets.Elem()
+		fmt.Printf("[%v, %v): %d\n", b.Lower, b.Upper, b.Count)
+	}
+	// Output:
+	// [-Inf, 50): 3
+	// [50, 100): 2
+	// [100, 500): 2
+	// [500, +Inf): 1
+}
@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math"
+	"slices"
+)
+
+// Histogram counts numbers falling into buckets defined by bounds.
+// Histogram with n bounds has n+1 buckets: k-th bucket contains numbers
+// in range [bounds[k-1], bounds[k]), the first bucket contains numbers less than bounds[0]
+// and the last bucket contains numbers greater than or equal to bounds[n-1].
+type Histogram struct {
+	bounds []float64
+	counts []int
+}
+
+// NewHistogram creates new empty Histogram with given bucket bounds.
+// Bounds are sorted and deduplicated.
+func NewHistogram(bounds []float64) *Histogram {
+	bounds = slices.Clone(bounds)
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+	return &Histogram{
+		bounds: bounds,
+		counts: make([]int, len(bounds)+1),
+	}
+}
+
+// HistogramOf creates Histogram with given bucket bounds containing all elements of iterator.
+func HistogramOf[T itertools.Number](i *itertools.Iterator[T], bounds []float64) *Histogram {
+	h := NewHistogram(bounds)
+	for i.Next() {
+		h.Add(float64(i.Elem()))
+	}
+	return h
+}
+
+// LinearBounds returns count bucket bounds starting from start
+// and increasing by width.
+func LinearBounds(start, width float64, count int) []float64 {
+	return itertools.Map(
+		itertools.Range(0, max(count, 0), 1),
+		func(k int) float64 { return start + float64(k)*width },
+	).Collect()
+}
+
+// ExponentialBounds returns count bucket bounds starting from start
+// and multiplied by factor each.
+func ExponentialBounds(start, factor float64, count int) []float64 {
+	return itertools.Iterate(start, func(bound float64) float64 {
+		return bound * factor
+	}).Limit(count).Collect()
+}
+
+// Add adds number x to the corresponding bucket.
+func (h *Histogram) Add(x float64) {
+	idx, found := slices.BinarySearch(h.bounds, x)
+	if found {
+		idx++
+	}
+	h.counts[idx]++
+}
+
+// Merge adds counts of other histogram to h.
+// Histograms must have the same bounds, otherwise Merge returns false
+// and does not change h.
+func (h *Histogram) Merge(other *Histogram) bool {
+	if !slices.Equal(h.bounds, other.bounds) {
+		return false
+	}
+	for idx, count := range other.counts {
+		h.counts[idx] += count
+	}
+	return true
+}
+
+// Bounds returns bucket bounds of the histogram.
+func (h *Histogram) Bounds() []float64 {
+	return slices.Clone(h.bounds)
+}
+
+// Counts returns amounts of numbers in every bucket of the histogram.
+func (h *Histogram) Counts() []int {
+	return slices.Clone(h.counts)
+}
+
+// Buckets returns iterator yielding lower bound, upper bound and count of every bucket.
+// Lower bound of the first bucket is -Inf and upper bound of the last one is +Inf.
+func (h *Histogram) Buckets() *itertools.Iterator[Bucket] {
+	return itertools.Map(
+		itertools.Range(0, len(h.counts), 1),
+		func(idx int) Bucket {
+			b := Bucket{
+				Lower: math.Inf(-1),
+				Upper: math.Inf(1),
+				Count: h.counts[idx],
+			}
+			if idx > 0 {
+				b.Lower = h.bounds[idx-1]
+			}
+			if idx < len(h.bounds) {
+				b.Upper = h.bounds[idx]
+			}
+			return b
+		},
+	)
+}
+
+// Bucket describes single bucket of Histogram,
+// containing Count numbers in range [Lower, Upper).
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count int
+}
@@ -0,0 +1,70 @@
+package stats_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/stats"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	t.Run("custom bounds", func(t *testing.T) {
+		h := stats.HistogramOf(
+			itertools.NewSliceIterator([]float64{-1, 0, 0.5, 1, 3, 7, 10, 12}),
+			[]float64{10, 0, 5},
+		)
+
+		if expected, result := []float64{0, 5, 10}, h.Bounds(); !slices.Equal(expected, result) {
+			t.Errorf("expected bounds %v, got %v", expected, result)
+		}
+		if expected, result := []int{1, 4, 1, 2}, h.Counts(); !slices.Equal(expected, result) {
+			t.Errorf("expected counts %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("linear bounds", func(t *testing.T) {
+		result := stats.LinearBounds(1, 0.5, 4)
+		if expected := []float64{1, 1.5, 2, 2.5}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("exponential bounds", func(t *testing.T) {
+		result := stats.ExponentialBounds(1, 10, 3)
+		if expected := []float64{1, 10, 100}; !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("buckets", func(t *testing.T) {
+		h := stats.HistogramOf(itertools.NewSliceIterator([]int{1, 2, 3}), []float64{2})
+
+		result := h.Buckets().Collect()
+		expected := []stats.Bucket{
+			{Lower: math.Inf(-1), Upper: 2, Count: 1},
+			{Lower: 2, Upper: math.Inf(1), Count: 2},
+		}
+
+		if !slices.Equal(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		bounds := stats.LinearBounds(0, 10, 3)
+		h1 := stats.HistogramOf(itertools.NewSliceIterator([]int{1, 11, 21}), bounds)
+		h2 := stats.HistogramOf(itertools.NewSliceIterator([]int{5, 25, 35}), bounds)
+
+		if !h1.Merge(h2) {
+			t.Fatalf("expected histograms to be merged")
+		}
+		if expected, result := []int{0, 2, 1, 3}, h1.Counts(); !slices.Equal(expected, result) {
+			t.Errorf("expected counts %v, got %v", expected, result)
+		}
+
+		if h1.Merge(stats.NewHistogram([]float64{1})) {
+			t.Errorf("did not expect histograms with different bounds to be merged")
+		}
+	})
+}
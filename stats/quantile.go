@@ -0,0 +1,204 @@
+package stats
+
+import (
+	"cmp"
+	"github.com/KSpaceer/itertools"
+	"math"
+	"slices"
+)
+
+// Median returns median of iterator elements.
+// If iterator is empty, Median returns NaN.
+// Median requires space complexity of O(n).
+func Median[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	return Quantile(i, 0.5)
+}
+
+// Quantile returns q-quantile of iterator elements, where q is in range [0, 1].
+// If q is between positions of two elements in sorted sequence,
+// the quantile is linearly interpolated between them.
+// If iterator is empty or q is out of range, Quantile returns NaN.
+// Quantile requires space complexity of O(n).
+// See TDigest for approximate quantiles computed in constant space.
+func Quantile[T itertools.Number](i *itertools.Iterator[T], q float64) float64 {
+	return Quantiles(i, q)[0]
+}
+
+// Quantiles returns q-quantiles of iterator elements for every given q (see Quantile).
+// Quantiles sorts elements only once, so it is preferable to multiple calls of Quantile.
+func Quantiles[T itertools.Number](i *itertools.Iterator[T], qs ...float64) []float64 {
+	values := itertools.Sorted(i).Collect()
+	result := make([]float64, len(qs))
+	for idx, q := range qs {
+		result[idx] = sortedQuantile(values, q)
+	}
+	return result
+}
+
+func sortedQuantile[T itertools.Number](values []T, q float64) float64 {
+	if len(values) == 0 || !(q >= 0 && q <= 1) {
+		return math.NaN()
+	}
+	pos := q * float64(len(values)-1)
+	lowerIdx := int(pos)
+	if lowerIdx >= len(values)-1 {
+		return float64(values[len(values)-1])
+	}
+	lower, upper := float64(values[lowerIdx]), float64(values[lowerIdx+1])
+	return lower + (upper-lower)*(pos-float64(lowerIdx))
+}
+
+// DefaultCompression is the default compression of TDigest.
+const DefaultCompression = 100
+
+// TDigest is a data structure for streaming estimation of quantiles
+// (merging t-digest by Ted Dunning). TDigest keeps bounded amount of weighted centroids,
+// providing accurate estimations for extreme quantiles (close to 0 or 1)
+// and less accurate ones for quantiles close to median.
+// TDigests can be merged, so digests of parts of data can be computed in parallel.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min, max    float64
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// NewTDigest creates new empty TDigest with given compression.
+// Higher compression provides better accuracy and requires more memory:
+// amount of centroids is O(compression).
+// If compression is non-positive, DefaultCompression is used.
+func NewTDigest(compression float64) *TDigest {
+	if compression <= 0 {
+		compression = DefaultCompression
+	}
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// TDigestOf creates TDigest with given compression containing all elements of iterator.
+func TDigestOf[T itertools.Number](i *itertools.Iterator[T], compression float64) *TDigest {
+	td := NewTDigest(compression)
+	for i.Next() {
+		td.Add(float64(i.Elem()))
+	}
+	return td
+}
+
+// Add adds number x to the digest.
+func (td *TDigest) Add(x float64) {
+	td.add(centroid{mean: x, weight: 1})
+}
+
+// Merge adds all numbers added to other digest to td.
+func (td *TDigest) Merge(other *TDigest) {
+	for _, c := range other.centroids {
+		td.add(c)
+	}
+	for _, c := range other.buffer {
+		td.add(c)
+	}
+}
+
+// Count returns amount of added numbers.
+func (td *TDigest) Count() int {
+	return int(td.count)
+}
+
+// Quantile returns estimation of q-quantile of added numbers, where q is in range [0, 1].
+// If no numbers were added or q is out of range, Quantile returns NaN.
+func (td *TDigest) Quantile(q float64) float64 {
+	td.compress()
+	if len(td.centroids) == 0 || !(q >= 0 && q <= 1) {
+		return math.NaN()
+	}
+	if len(td.centroids) == 1 {
+		return td.centroids[0].mean
+	}
+
+	target := q * td.count
+	first := td.centroids[0]
+	if target < first.weight/2 {
+		return interpolate(td.min, first.mean, target/(first.weight/2))
+	}
+	center := first.weight / 2
+	for idx := 1; idx < len(td.centroids); idx++ {
+		prev, cur := td.centroids[idx-1], td.centroids[idx]
+		nextCenter := center + (prev.weight+cur.weight)/2
+		if target < nextCenter {
+			return interpolate(prev.mean, cur.mean, (target-center)/(nextCenter-center))
+		}
+		center = nextCenter
+	}
+	last := td.centroids[len(td.centroids)-1]
+	return interpolate(last.mean, td.max, (target-center)/(last.weight/2))
+}
+
+func (td *TDigest) add(c centroid) {
+	td.buffer = append(td.buffer, c)
+	td.count += c.weight
+	td.min = min(td.min, c.mean)
+	td.max = max(td.max, c.mean)
+	if len(td.buffer) >= td.bufferSize() {
+		td.compress()
+	}
+}
+
+func (td *TDigest) bufferSize() int {
+	return int(5 * td.compression)
+}
+
+// compress merges buffered centroids with existing ones,
+// keeping every centroid within size limit defined by scale function.
+func (td *TDigest) compress() {
+	if len(td.buffer) == 0 {
+		return
+	}
+	all := append(td.centroids, td.buffer...)
+	td.buffer = td.buffer[:0]
+	slices.SortFunc(all, func(a, b centroid) int {
+		return cmp.Compare(a.mean, b.mean)
+	})
+
+	merged := make([]centroid, 0, len(all))
+	current := all[0]
+	var weightSoFar float64
+	weightLimit := td.count * td.scaleInverse(td.scale(0)+1)
+	for _, c := range all[1:] {
+		if weightSoFar+current.weight+c.weight <= weightLimit {
+			weight := current.weight + c.weight
+			current.mean += (c.mean - current.mean) * c.weight / weight
+			current.weight = weight
+			continue
+		}
+		weightSoFar += current.weight
+		weightLimit = td.count * td.scaleInverse(td.scale(weightSoFar/td.count)+1)
+		merged = append(merged, current)
+		current = c
+	}
+	td.centroids = append(merged, current)
+}
+
+// scale maps quantile to scale index, making centroids near the tails smaller.
+func (td *TDigest) scale(q float64) float64 {
+	return td.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+func (td *TDigest) scaleInverse(k float64) float64 {
+	if k >= td.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/td.compression) + 1) / 2
+}
+
+func interpolate(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
@@ -0,0 +1,104 @@
+package stats_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/stats"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestQuantile(t *testing.T) {
+	t.Run("odd median", func(t *testing.T) {
+		result := stats.Median(itertools.NewSliceIterator([]int{5, 1, 3}))
+		if expected := 3.0; result != expected {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("even median", func(t *testing.T) {
+		result := stats.Median(itertools.NewSliceIterator([]int{5, 1, 3, 4}))
+		if expected := 3.5; result != expected {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("quantiles", func(t *testing.T) {
+		result := stats.Quantiles(itertools.Range(0, 101, 1), 0, 0.25, 0.9, 1)
+
+		expected := []float64{0, 25, 90, 100}
+
+		for k := range expected {
+			if !almostEqual(result[k], expected[k]) {
+				t.Errorf("expected %v, got %v", expected, result)
+				break
+			}
+		}
+	})
+
+	t.Run("invalid quantile", func(t *testing.T) {
+		if result := stats.Quantile(itertools.Range(0, 10, 1), 1.5); !math.IsNaN(result) {
+			t.Errorf("expected NaN, got %v", result)
+		}
+		if result := stats.Median(itertools.NewSliceIterator([]int{})); !math.IsNaN(result) {
+			t.Errorf("expected NaN, got %v", result)
+		}
+	})
+}
+
+func TestTDigest(t *testing.T) {
+	const n = 100000
+	rng := rand.New(rand.NewSource(1))
+	data := make([]float64, n)
+	for k := range data {
+		data[k] = rng.Float64()
+	}
+
+	t.Run("uniform distribution", func(t *testing.T) {
+		td := stats.TDigestOf(itertools.NewSliceIterator(data), stats.DefaultCompression)
+
+		if td.Count() != n {
+			t.Errorf("expected count %d, got %d", n, td.Count())
+		}
+		for _, q := range []float64{0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999} {
+			if result := td.Quantile(q); math.Abs(result-q) > 0.01 {
+				t.Errorf("expected quantile %v to be close to %v, got %v", q, q, result)
+			}
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		td1 := stats.TDigestOf(itertools.NewSliceIterator(data[:n/2]), 0)
+		td2 := stats.TDigestOf(itertools.NewSliceIterator(data[n/2:]), 0)
+		td1.Merge(td2)
+
+		if td1.Count() != n {
+			t.Errorf("expected count %d, got %d", n, td1.Count())
+		}
+		for _, q := range []float64{0.01, 0.5, 0.99} {
+			if result := td1.Quantile(q); math.Abs(result-q) > 0.01 {
+				t.Errorf("expected quantile %v to be close to %v, got %v", q, q, result)
+			}
+		}
+	})
+
+	t.Run("small", func(t *testing.T) {
+		td := stats.TDigestOf(itertools.NewSliceIterator([]int{1, 2, 3, 4, 5}), 0)
+		if result := td.Quantile(0); result != 1 {
+			t.Errorf("expected %v, got %v", 1, result)
+		}
+		if result := td.Quantile(1); result != 5 {
+			t.Errorf("expected %v, got %v", 5, result)
+		}
+		if result := td.Quantile(0.5); result != 3 {
+			t.Errorf("expected %v, got %v", 3, result)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		td := stats.NewTDigest(0)
+		if result := td.Quantile(0.5); !math.IsNaN(result) {
+			t.Errorf("expected NaN, got %v", result)
+		}
+	})
+}
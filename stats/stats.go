@@ -0,0 +1,149 @@
+package stats
+
+import (
+	"github.com/KSpaceer/itertools"
+	"math"
+)
+
+// Moments accumulates count, mean and variance of numbers in a single pass
+// using Welford's algorithm, which is numerically stable.
+// Zero value of Moments is ready to use.
+type Moments struct {
+	count int
+	mean  float64
+	m2    float64
+}
+
+// Add adds number x to accumulated moments.
+func (m *Moments) Add(x float64) {
+	m.count++
+	delta := x - m.mean
+	m.mean += delta / float64(m.count)
+	m.m2 += delta * (x - m.mean)
+}
+
+// Merge adds moments accumulated by other to m.
+// Merge allows to combine moments computed for parts of data in parallel.
+func (m *Moments) Merge(other Moments) {
+	if other.count == 0 {
+		return
+	}
+	if m.count == 0 {
+		*m = other
+		return
+	}
+	count := m.count + other.count
+	delta := other.mean - m.mean
+	m.mean += delta * float64(other.count) / float64(count)
+	m.m2 += other.m2 + delta*delta*float64(m.count)*float64(other.count)/float64(count)
+	m.count = count
+}
+
+// Count returns amount of added numbers.
+func (m *Moments) Count() int {
+	return m.count
+}
+
+// Mean returns arithmetic mean of added numbers.
+// If no numbers were added, Mean returns NaN.
+func (m *Moments) Mean() float64 {
+	if m.count == 0 {
+		return math.NaN()
+	}
+	return m.mean
+}
+
+// Variance returns sample (unbiased) variance of added numbers.
+// If fewer than two numbers were added, Variance returns NaN.
+func (m *Moments) Variance() float64 {
+	if m.count < 2 {
+		return math.NaN()
+	}
+	return m.m2 / float64(m.count-1)
+}
+
+// PopulationVariance returns population variance of added numbers.
+// If no numbers were added, PopulationVariance returns NaN.
+func (m *Moments) PopulationVariance() float64 {
+	if m.count == 0 {
+		return math.NaN()
+	}
+	return m.m2 / float64(m.count)
+}
+
+// StdDev returns sample standard deviation of added numbers.
+// If fewer than two numbers were added, StdDev returns NaN.
+func (m *Moments) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// MomentsOf accumulates Moments of all elements of iterator.
+func MomentsOf[T itertools.Number](i *itertools.Iterator[T]) Moments {
+	var m Moments
+	for i.Next() {
+		m.Add(float64(i.Elem()))
+	}
+	return m
+}
+
+// Mean returns arithmetic mean of iterator elements.
+// If iterator is empty, Mean returns NaN.
+func Mean[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	m := MomentsOf(i)
+	return m.Mean()
+}
+
+// Variance returns sample (unbiased) variance of iterator elements.
+// If iterator has fewer than two elements, Variance returns NaN.
+func Variance[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	m := MomentsOf(i)
+	return m.Variance()
+}
+
+// PopulationVariance returns population variance of iterator elements.
+// If iterator is empty, PopulationVariance returns NaN.
+func PopulationVariance[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	m := MomentsOf(i)
+	return m.PopulationVariance()
+}
+
+// StdDev returns sample standard deviation of iterator elements.
+// If iterator has fewer than two elements, StdDev returns NaN.
+func StdDev[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	m := MomentsOf(i)
+	return m.StdDev()
+}
+
+// Sum returns sum of iterator elements as float64 using compensated
+// (Kahan-Babuska-Neumaier) summation, which significantly reduces
+// accumulated rounding error comparing to naive summation.
+// If the sum is infinite (some element is infinite or the sum overflows float64),
+// Sum returns the infinity like naive summation does.
+func Sum[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	var sum, compensation float64
+	for i.Next() {
+		x := float64(i.Elem())
+		t := sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			compensation += (sum - t) + x
+		} else {
+			compensation += (x - t) + sum
+		}
+		sum = t
+	}
+	// compensation of infinite sum is NaN (Inf - Inf), while the sum itself is correct
+	if math.IsInf(sum, 0) {
+		return sum
+	}
+	return sum + compensation
+}
+
+// Product returns product of iterator elements as float64.
+// Product of empty iterator is 1.
+func Product[T itertools.Number](i *itertools.Iterator[T]) float64 {
+	product := 1.0
+	for i.Next() {
+		product *= float64(i.Elem())
+	}
+	return product
+}
@@ -0,0 +1,139 @@
+package stats_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/stats"
+	"math"
+	"testing"
+)
+
+func TestMoments(t *testing.T) {
+	data := []int{6, 10, 7, 12, 6, 14, 8, 13, 10, 14}
+
+	t.Run("mean", func(t *testing.T) {
+		result := stats.Mean(itertools.NewSliceIterator(data))
+		if expected := 10.0; !almostEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("variance", func(t *testing.T) {
+		result := stats.Variance(itertools.NewSliceIterator(data))
+		if expected := 90.0 / 9; !almostEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("population variance", func(t *testing.T) {
+		result := stats.PopulationVariance(itertools.NewSliceIterator(data))
+		if expected := 90.0 / 10; !almostEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("std dev", func(t *testing.T) {
+		result := stats.StdDev(itertools.NewSliceIterator(data))
+		if expected := math.Sqrt(10); !almostEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if result := stats.Mean(itertools.NewSliceIterator([]float64{})); !math.IsNaN(result) {
+			t.Errorf("expected NaN, got %v", result)
+		}
+		if result := stats.Variance(itertools.NewSliceIterator([]float64{1})); !math.IsNaN(result) {
+			t.Errorf("expected NaN, got %v", result)
+		}
+	})
+
+	t.Run("numerical stability", func(t *testing.T) {
+		const offset = 1e9
+		result := stats.Variance(itertools.Map(
+			itertools.NewSliceIterator([]float64{4, 7, 13, 16}),
+			func(x float64) float64 { return x + offset },
+		))
+		if expected := 30.0; !almostEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		m1 := stats.MomentsOf(itertools.NewSliceIterator(data[:3]))
+		m2 := stats.MomentsOf(itertools.NewSliceIterator(data[3:]))
+		m1.Merge(m2)
+
+		expected := stats.MomentsOf(itertools.NewSliceIterator(data))
+
+		if m1.Count() != expected.Count() {
+			t.Errorf("expected count %d, got %d", expected.Count(), m1.Count())
+		}
+		if !almostEqual(m1.Mean(), expected.Mean()) {
+			t.Errorf("expected mean %v, got %v", expected.Mean(), m1.Mean())
+		}
+		if !almostEqual(m1.Variance(), expected.Variance()) {
+			t.Errorf("expected variance %v, got %v", expected.Variance(), m1.Variance())
+		}
+	})
+}
+
+func TestSum(t *testing.T) {
+	t.Run("compensated sum", func(t *testing.T) {
+		data := append([]float64{1}, itertools.RepeatN(1e-16, 10000).Collect()...)
+
+		result := stats.Sum(itertools.NewSliceIterator(data))
+		if expected := 1 + 1e-12; !almostEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("large and small", func(t *testing.T) {
+		result := stats.Sum(itertools.NewSliceIterator([]float64{1, 1e100, 1, -1e100}))
+		if expected := 2.0; result != expected {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("infinite sum", func(t *testing.T) {
+		tcases := []struct {
+			data     []float64
+			expected float64
+		}{
+			{data: []float64{1, math.Inf(1)}, expected: math.Inf(1)},
+			{data: []float64{math.Inf(-1), 1, 2}, expected: math.Inf(-1)},
+			{data: []float64{1e308, 1e308}, expected: math.Inf(1)},
+			{data: []float64{-1e308, -1e308, 1}, expected: math.Inf(-1)},
+		}
+		for _, tc := range tcases {
+			if result := stats.Sum(itertools.NewSliceIterator(tc.data)); result != tc.expected {
+				t.Errorf("%v: expected %v, got %v", tc.data, tc.expected, result)
+			}
+		}
+	})
+
+	t.Run("sum with opposite infinities", func(t *testing.T) {
+		result := stats.Sum(itertools.NewSliceIterator([]float64{math.Inf(1), math.Inf(-1)}))
+		if !math.IsNaN(result) {
+			t.Errorf("expected NaN, got %v", result)
+		}
+	})
+
+	t.Run("product", func(t *testing.T) {
+		result := stats.Product(itertools.NewSliceIterator([]int{1, 2, 3, 4}))
+		if expected := 24.0; result != expected {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty product", func(t *testing.T) {
+		result := stats.Product(itertools.NewSliceIterator([]int{}))
+		if expected := 1.0; result != expected {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func almostEqual(a, b float64) bool {
+	const eps = 1e-9
+	return math.Abs(a-b) <= eps*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
@@ -0,0 +1,168 @@
+package itertools
+
+import (
+	"math"
+	"time"
+)
+
+// BatchedTimeout creates new iterator that returns slices of values received from channel ch
+// (aka batches) with size up to batchSize. Unlike Batched, the batch is returned
+// when either it reaches batchSize or maxWait passes since the first value of the batch was received,
+// so values from slow channel don't wait for the batch to fill.
+// If maxWait is not positive, batches are returned only when they are full or ch is closed.
+// The iteration is over when ch is closed.
+// If batchSize is not positive, returns empty iterator.
+func BatchedTimeout[T any](ch <-chan T, batchSize int, maxWait time.Duration, opts ...TimingOption) *Iterator[[]T] {
+	if batchSize <= 0 {
+		return New(func() ([]T, bool) {
+			return nil, false
+		})
+	}
+	options := newTimingOptions(opts)
+	var closed bool
+	return New(func() ([]T, bool) {
+		if closed {
+			return nil, false
+		}
+		v, ok := <-ch
+		if !ok {
+			closed = true
+			return nil, false
+		}
+		batch := make([]T, 1, batchSize)
+		batch[0] = v
+
+		var timeout <-chan time.Time
+		if maxWait > 0 {
+			timer := options.clock.NewTimer(maxWait)
+			defer timer.Stop()
+			timeout = timer.C()
+		}
+		for len(batch) < batchSize {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					closed = true
+					return batch, true
+				}
+				batch = append(batch, v)
+			case <-timeout:
+				return batch, true
+			}
+		}
+		return batch, true
+	})
+}
+
+// Throttle creates new iterator that yields elements of source iterator
+// at the pace of at most rate elements per second, allowing bursts of up to burst elements
+// (token bucket algorithm). The bucket is initially full. Next element is requested
+// from source iterator only after the pace allows it, so lazy sources (e.g. Map
+// calling external API) are throttled as well.
+// If rate is not positive, elements are not throttled. If burst is less than 1, 1 is used.
+func Throttle[T any](i *Iterator[T], rate float64, burst int, opts ...TimingOption) *Iterator[T] {
+	if rate <= 0 {
+		return Map(i, identity[T])
+	}
+	burst = max(burst, 1)
+	var (
+		options = newTimingOptions(opts)
+		tokens  = float64(burst)
+		last    = options.clock.Now()
+	)
+	refill := func() {
+		now := options.clock.Now()
+		tokens = min(float64(burst), tokens+now.Sub(last).Seconds()*rate)
+		last = now
+	}
+	return New(func() (T, bool) {
+		for refill(); tokens < 1; refill() {
+			wait := time.Duration(math.Ceil((1 - tokens) / rate * float64(time.Second)))
+			<-options.clock.NewTimer(wait).C()
+		}
+		tokens--
+		return i.f()
+	}).onClose(i.Close).withHint(i.sizeHint)
+}
+
+// Debounce creates new iterator that yields values received from channel ch
+// only after no new values were received for duration d, i.e. only the last value
+// of every burst of values is yielded. The pending value is yielded when ch is closed.
+// The iteration is over when ch is closed.
+func Debounce[T any](ch <-chan T, d time.Duration, opts ...TimingOption) *Iterator[T] {
+	var (
+		options = newTimingOptions(opts)
+		zero    T
+		closed  bool
+	)
+	return New(func() (T, bool) {
+		if closed {
+			return zero, false
+		}
+		pending, ok := <-ch
+		if !ok {
+			closed = true
+			return zero, false
+		}
+		for {
+			timer := options.clock.NewTimer(d)
+			select {
+			case v, ok := <-ch:
+				timer.Stop()
+				if !ok {
+					closed = true
+					return pending, true
+				}
+				pending = v
+			case <-timer.C():
+				return pending, true
+			}
+		}
+	})
+}
+
+// Sample creates new iterator that yields the latest value received from channel ch
+// during every period d, skipping periods without values.
+// Periods are measured since the iterator started waiting for the next value.
+// The pending value is yielded when ch is closed.
+// The iteration is over when ch is closed.
+// If d is not positive, returns empty iterator.
+func Sample[T any](ch <-chan T, d time.Duration, opts ...TimingOption) *Iterator[T] {
+	var zero T
+	if d <= 0 {
+		return New(func() (T, bool) {
+			return zero, false
+		})
+	}
+	var (
+		options = newTimingOptions(opts)
+		closed  bool
+	)
+	return New(func() (T, bool) {
+		var (
+			latest T
+			has    bool
+		)
+		for !closed {
+			timer := options.clock.NewTimer(d)
+			for waiting := true; waiting; {
+				select {
+				case v, ok := <-ch:
+					if !ok {
+						timer.Stop()
+						closed = true
+						waiting = false
+						continue
+					}
+					latest, has = v, true
+				case <-timer.C():
+					waiting = false
+				}
+			}
+			if has {
+				return latest, true
+			}
+		}
+		return zero, false
+	})
+}
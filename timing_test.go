@@ -0,0 +1,219 @@
+package itertools_test
+
+import (
+	"github.com/KSpaceer/itertools"
+	"github.com/KSpaceer/itertools/clock"
+	"testing"
+	"time"
+)
+
+func TestBatchedTimeout(t *testing.T) {
+	const maxWait = time.Second
+
+	t.Run("full batch", func(t *testing.T) {
+		ch := make(chan int, 5)
+		for k := range 5 {
+			ch <- k
+		}
+		close(ch)
+
+		result := itertools.BatchedTimeout(ch, 2, maxWait, itertools.WithClock(clock.NewFake(time.Time{}))).Collect()
+
+		expected := [][]int{{0, 1}, {2, 3}, {4}}
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		fake := clock.NewFake(time.Time{})
+		ch := make(chan int, 3)
+		iter := itertools.BatchedTimeout(ch, 3, maxWait, itertools.WithClock(fake))
+
+		ch <- 1
+		ch <- 2
+		batches := make(chan []int)
+		go func() {
+			defer close(batches)
+			for iter.Next() {
+				batches <- iter.Elem()
+			}
+		}()
+
+		fake.BlockUntil(1)
+		fake.Advance(maxWait / 2)
+		select {
+		case batch := <-batches:
+			t.Fatalf("unexpected batch before timeout: %v", batch)
+		default:
+		}
+		fake.Advance(maxWait / 2)
+
+		if batch := <-batches; !sliceEqual([]int{1, 2}, batch) {
+			t.Errorf("expected [1 2], got %v", batch)
+		}
+
+		ch <- 3
+		close(ch)
+		if batch := <-batches; !sliceEqual([]int{3}, batch) {
+			t.Errorf("expected [3], got %v", batch)
+		}
+		if batch, ok := <-batches; ok {
+			t.Errorf("expected no more batches, got %v", batch)
+		}
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		result := itertools.BatchedTimeout(ch, 2, 0).Collect()
+
+		expected := [][]int{{1, 2}, {3}}
+		if !nestedSliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		if itertools.BatchedTimeout(make(chan int), 0, maxWait).Next() {
+			t.Errorf("expected empty iterator")
+		}
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	t.Run("token bucket", func(t *testing.T) {
+		fake := clock.NewFake(time.Time{})
+		iter := itertools.Throttle(itertools.Range(0, 4, 1), 10, 2, itertools.WithClock(fake))
+
+		values := make(chan int)
+		go func() {
+			defer close(values)
+			for iter.Next() {
+				values <- iter.Elem()
+			}
+		}()
+
+		// burst is available immediately
+		for expected := range 2 {
+			if v := <-values; v != expected {
+				t.Errorf("expected %d, got %d", expected, v)
+			}
+		}
+
+		for expected := 2; expected < 4; expected++ {
+			fake.BlockUntil(1)
+			select {
+			case v := <-values:
+				t.Fatalf("unexpected value before refill: %d", v)
+			default:
+			}
+			fake.Advance(100 * time.Millisecond)
+			if v := <-values; v != expected {
+				t.Errorf("expected %d, got %d", expected, v)
+			}
+		}
+
+		fake.BlockUntil(1)
+		fake.Advance(100 * time.Millisecond)
+		if v, ok := <-values; ok {
+			t.Errorf("expected no more values, got %d", v)
+		}
+	})
+
+	t.Run("no rate", func(t *testing.T) {
+		result := itertools.Throttle(itertools.NewSliceIterator([]int{1, 2, 3}), 0, 1).Collect()
+
+		if expected := []int{1, 2, 3}; !sliceEqual(expected, result) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestDebounce(t *testing.T) {
+	fake := clock.NewFake(time.Time{})
+	ch := make(chan int)
+	iter := itertools.Debounce(ch, time.Second, itertools.WithClock(fake))
+
+	values := make(chan int)
+	go func() {
+		defer close(values)
+		for iter.Next() {
+			values <- iter.Elem()
+		}
+	}()
+
+	ch <- 1
+	ch <- 2
+	fake.BlockUntil(1)
+	fake.Advance(time.Second / 2)
+	select {
+	case v := <-values:
+		t.Fatalf("unexpected value before debounce period passed: %d", v)
+	default:
+	}
+	ch <- 3
+
+	// the timer for the previous value may be still active for a moment,
+	// so time is advanced until the value is yielded
+	var v int
+	for received := false; !received; {
+		fake.Advance(time.Second)
+		select {
+		case v = <-values:
+			received = true
+		case <-time.After(time.Millisecond):
+		}
+	}
+	if v != 3 {
+		t.Errorf("expected 3, got %d", v)
+	}
+
+	ch <- 4
+	close(ch)
+	if v := <-values; v != 4 {
+		t.Errorf("expected 4, got %d", v)
+	}
+	if v, ok := <-values; ok {
+		t.Errorf("expected no more values, got %d", v)
+	}
+}
+
+func TestSample(t *testing.T) {
+	fake := clock.NewFake(time.Time{})
+	ch := make(chan int)
+	iter := itertools.Sample(ch, time.Second, itertools.WithClock(fake))
+
+	values := make(chan int)
+	go func() {
+		defer close(values)
+		for iter.Next() {
+			values <- iter.Elem()
+		}
+	}()
+
+	fake.BlockUntil(1)
+	ch <- 1
+	ch <- 2
+	fake.Advance(time.Second)
+	if v := <-values; v != 2 {
+		t.Errorf("expected 2, got %d", v)
+	}
+
+	// empty period is skipped
+	fake.BlockUntil(1)
+	fake.Advance(time.Second)
+	fake.BlockUntil(1)
+	ch <- 3
+	close(ch)
+	if v := <-values; v != 3 {
+		t.Errorf("expected 3, got %d", v)
+	}
+	if v, ok := <-values; ok {
+		t.Errorf("expected no more values, got %d", v)
+	}
+}
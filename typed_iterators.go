@@ -10,15 +10,29 @@ import (
 func NewSliceIterator[S ~[]T, T any](s S) *Iterator[T] {
 	var (
 		idx  int
+		end  = len(s)
 		zero T
 	)
 	return New(func() (T, bool) {
-		if idx >= len(s) {
+		if idx >= end {
 			return zero, false
 		}
 		v := s[idx]
 		idx++
 		return v, true
+	}).withHint(func() (int, int) {
+		remaining := max(end-idx, 0)
+		return remaining, remaining
+	}).withSkip(func(n int) int {
+		skipped := min(n, max(end-idx, 0))
+		idx += skipped
+		return skipped
+	}).withBack(func() (T, bool) {
+		if end <= idx {
+			return zero, false
+		}
+		end--
+		return s[end], true
 	})
 }
 
@@ -39,14 +53,20 @@ func NewChanIterator[T any](ch <-chan T) *Iterator[T] {
 // NewMapIterator uses reflect package to keep iteration state.
 func NewMapIterator[K comparable, V any](m map[K]V) *Iterator[Pair[K, V]] {
 	mapIter := reflect.ValueOf(m).MapRange()
+	remaining := len(m)
 	return New(func() (Pair[K, V], bool) {
 		if !mapIter.Next() {
+			remaining = 0
 			return Pair[K, V]{}, false
 		}
+		remaining--
 		return Pair[K, V]{
 			First:  mapIter.Key().Interface().(K),
 			Second: mapIter.Value().Interface().(V),
 		}, true
+	}).withHint(func() (int, int) {
+		remaining := max(remaining, 0)
+		return remaining, remaining
 	})
 }
 
@@ -54,12 +74,18 @@ func NewMapIterator[K comparable, V any](m map[K]V) *Iterator[Pair[K, V]] {
 // NewMapKeysIterator uses reflect package to keep iteration state.
 func NewMapKeysIterator[K comparable, V any](m map[K]V) *Iterator[K] {
 	mapIter := reflect.ValueOf(m).MapRange()
+	remaining := len(m)
 	return New(func() (K, bool) {
 		if !mapIter.Next() {
+			remaining = 0
 			var zero K
 			return zero, false
 		}
+		remaining--
 		return mapIter.Key().Interface().(K), true
+	}).withHint(func() (int, int) {
+		remaining := max(remaining, 0)
+		return remaining, remaining
 	})
 }
 
@@ -67,12 +93,18 @@ func NewMapKeysIterator[K comparable, V any](m map[K]V) *Iterator[K] {
 // NewMapValuesIterator uses reflect package to keep iteration state.
 func NewMapValuesIterator[K comparable, V any](m map[K]V) *Iterator[V] {
 	mapIter := reflect.ValueOf(m).MapRange()
+	remaining := len(m)
 	return New(func() (V, bool) {
 		if !mapIter.Next() {
+			remaining = 0
 			var zero V
 			return zero, false
 		}
+		remaining--
 		return mapIter.Value().Interface().(V), true
+	}).withHint(func() (int, int) {
+		remaining := max(remaining, 0)
+		return remaining, remaining
 	})
 }
 
@@ -81,21 +113,52 @@ func NewMapValuesIterator[K comparable, V any](m map[K]V) *Iterator[V] {
 func NewAsciiIterator(s string) *Iterator[byte] {
 	var (
 		idx  int
+		end  = len(s)
 		zero byte
 	)
 	return New(func() (byte, bool) {
-		if idx >= len(s) {
+		if idx >= end {
 			return zero, false
 		}
 		v := s[idx]
 		idx++
 		return v, true
+	}).withHint(func() (int, int) {
+		remaining := max(end-idx, 0)
+		return remaining, remaining
+	}).withSkip(func(n int) int {
+		skipped := min(n, max(end-idx, 0))
+		idx += skipped
+		return skipped
+	}).withBack(func() (byte, bool) {
+		if end <= idx {
+			return zero, false
+		}
+		end--
+		return s[end], true
 	})
 }
 
 // NewUTF8Iterator creates iterator yielding runes from string
 // (interpreting string as []rune)
 func NewUTF8Iterator(s string) *Iterator[rune] {
+	// iteration stops at the first invalid rune, so bounds are computed
+	// from the valid prefix of the string, which is found once on demand
+	var trimmed bool
+	trim := func() {
+		if trimmed {
+			return
+		}
+		trimmed = true
+		for idx := 0; idx < len(s); {
+			r, size := utf8.DecodeRuneInString(s[idx:])
+			if r == utf8.RuneError {
+				s = s[:idx]
+				return
+			}
+			idx += size
+		}
+	}
 	return New(func() (rune, bool) {
 		r, size := utf8.DecodeRuneInString(s)
 		if r == utf8.RuneError {
@@ -103,5 +166,16 @@ func NewUTF8Iterator(s string) *Iterator[rune] {
 		}
 		s = s[size:]
 		return r, true
+	}).withHint(func() (int, int) {
+		trim()
+		return (len(s) + utf8.UTFMax - 1) / utf8.UTFMax, len(s)
+	}).withBack(func() (rune, bool) {
+		trim()
+		r, size := utf8.DecodeLastRuneInString(s)
+		if r == utf8.RuneError {
+			return 0, false
+		}
+		s = s[:len(s)-size]
+		return r, true
 	})
 }
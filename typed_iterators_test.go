@@ -112,6 +112,16 @@ func TestSliceIterator(t *testing.T) {
 			{
 				name: "negative step",
 				step: -1,
+				expected: func() []int {
+					result := slices.Clone(s)
+					slices.Reverse(result)
+					return result
+				}(),
+			},
+			{
+				name:     "min int step",
+				step:     math.MinInt,
+				expected: []int{s[len(s)-1]},
 			},
 			{
 				name:     "giant step",
@@ -605,6 +615,11 @@ func TestChanIterator(t *testing.T) {
 			{
 				name: "negative step",
 				step: -1,
+				expected: func() []complex128 {
+					result := slices.Clone(s)
+					slices.Reverse(result)
+					return result
+				}(),
 			},
 			{
 				name:     "giant step",
@@ -2134,6 +2149,11 @@ func TestAsciiIterator(t *testing.T) {
 			{
 				name: "negative step",
 				step: -1,
+				expected: func() string {
+					result := []byte(text)
+					slices.Reverse(result)
+					return string(result)
+				}(),
 			},
 			{
 				name:     "giant step",
@@ -2650,6 +2670,11 @@ func TestUTF8Iterator(t *testing.T) {
 			{
 				name: "negative step",
 				step: -1,
+				expected: func() string {
+					result := []rune(text)
+					slices.Reverse(result)
+					return string(result)
+				}(),
 			},
 			{
 				name: "giant step",